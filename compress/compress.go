@@ -0,0 +1,201 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/compress
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package compress provides a uniform api for compressing byte slices and streams with gzip, zlib and zstd
+package compress
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Codec identifies a compression format
+type Codec string
+
+// Compression Codecs
+const (
+	Gzip Codec = "gzip" // RFC 1952 (e.g. Content-Encoding: gzip)
+	Zlib Codec = "zlib" // RFC 1950 (e.g. Content-Encoding: deflate)
+	Zstd Codec = "zstd" // Zstandard (RFC 8878)
+)
+
+// Level trades compression speed for size
+type Level int
+
+// Compression Levels
+const (
+	DefaultCompression Level = iota // balance of speed and size
+	Fastest                         // fastest compression
+	BestCompression                 // smallest output
+)
+
+// ErrTooLarge is returned by Decompress and readers created with NewReader when the
+// decompressed data exceeds Options.MaxSize
+var ErrTooLarge = errors.New("Decompressed data exceeds the maximum size")
+
+// Options configure Compress, Decompress, NewReader and NewWriter
+type Options struct {
+	// Level is the compression Level (default DefaultCompression)
+	Level Level
+
+	// MaxSize limits the bytes decompressed, failing with ErrTooLarge instead of
+	// exhausting memory on untrusted payloads (default none)
+	MaxSize int64
+}
+
+// Compress simply returns data compressed with a Codec
+func Compress(data []byte, codec Codec, options ...Options) ([]byte, error) {
+	var buffer bytes.Buffer
+	w, err := NewWriter(&buffer, codec, options...)
+	if err != nil {
+		return nil, err
+	}
+	_, err = w.Write(data)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Decompress simply returns data decompressed with a Codec, failing with ErrTooLarge
+// when the result exceeds Options.MaxSize
+func Decompress(data []byte, codec Codec, options ...Options) ([]byte, error) {
+	r, err := NewReader(bytes.NewReader(data), codec, options...)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// NewWriter returns a writer compressing to w with a Codec, the compressed stream is
+// only complete once the writer is closed (closing it doesn't close w)
+func NewWriter(w io.Writer, codec Codec, options ...Options) (io.WriteCloser, error) {
+
+	// Apply Options
+	compressOptions := Options{}
+	if len(options) > 0 {
+		compressOptions = options[0]
+	}
+
+	switch normalCodec(codec) {
+	case Gzip:
+		return gzip.NewWriterLevel(w, compressOptions.Level.flate())
+	case Zlib:
+		return zlib.NewWriterLevel(w, compressOptions.Level.flate())
+	case Zstd:
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(compressOptions.Level.zstd()))
+	}
+	return nil, fmt.Errorf("Compression codec '%v' is not supported", codec)
+}
+
+// NewReader returns a reader decompressing r with a Codec, failing with ErrTooLarge
+// once more than Options.MaxSize bytes are read (closing it doesn't close r)
+func NewReader(r io.Reader, codec Codec, options ...Options) (io.ReadCloser, error) {
+
+	// Apply Options
+	compressOptions := Options{}
+	if len(options) > 0 {
+		compressOptions = options[0]
+	}
+
+	var reader io.ReadCloser
+	switch normalCodec(codec) {
+	case Gzip:
+		decompressed, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to read gzip data: %w", err)
+		}
+		reader = decompressed
+	case Zlib:
+		decompressed, err := zlib.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to read zlib data: %w", err)
+		}
+		reader = decompressed
+	case Zstd:
+		decoder, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to read zstd data: %w", err)
+		}
+		reader = decoder.IOReadCloser()
+	default:
+		return nil, fmt.Errorf("Compression codec '%v' is not supported", codec)
+	}
+
+	// Limit Decompressed Size
+	if compressOptions.MaxSize > 0 {
+		return &limitedReader{reader: reader, remaining: compressOptions.MaxSize}, nil
+	}
+	return reader, nil
+}
+
+// normalCodec returns a Codec in lower case
+func normalCodec(codec Codec) Codec {
+	return Codec(strings.ToLower(string(codec)))
+}
+
+// flate returns the compress/flate level (used by gzip and zlib) for a Level
+func (level Level) flate() int {
+	switch level {
+	case Fastest:
+		return flate.BestSpeed
+	case BestCompression:
+		return flate.BestCompression
+	}
+	return flate.DefaultCompression
+}
+
+// zstd returns the zstd encoder level for a Level
+func (level Level) zstd() zstd.EncoderLevel {
+	switch level {
+	case Fastest:
+		return zstd.SpeedFastest
+	case BestCompression:
+		return zstd.SpeedBestCompression
+	}
+	return zstd.SpeedDefault
+}
+
+// limitedReader is an io.ReadCloser failing with ErrTooLarge once more than the
+// remaining bytes are read
+type limitedReader struct {
+	reader    io.ReadCloser
+	remaining int64
+}
+
+// Read reads from the underlying reader, failing once the limit is exceeded
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+	n, err := r.reader.Read(p)
+	if int64(n) > r.remaining {
+		n, r.remaining = int(r.remaining), 0
+		return n, ErrTooLarge
+	}
+	r.remaining -= int64(n)
+	return n, err
+}
+
+// Close closes the underlying reader
+func (r *limitedReader) Close() error {
+	return r.reader.Close()
+}
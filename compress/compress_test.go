@@ -0,0 +1,72 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/compress
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package compress
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCompress is a unit test for compress.Compress() and compress.Decompress()
+func TestCompress(t *testing.T) {
+	data := bytes.Repeat([]byte("gogo compress "), 1000)
+
+	for _, codec := range []Codec{Gzip, Zlib, Zstd, "GZIP"} {
+		for _, level := range []Level{DefaultCompression, Fastest, BestCompression} {
+			compressed, err := Compress(data, codec, Options{Level: level})
+			// Assert Unit Test
+			assert.NoError(t, err, codec)
+			assert.Less(t, len(compressed), len(data)/10, codec)
+
+			decompressed, err := Decompress(compressed, codec)
+			assert.NoError(t, err, codec)
+			assert.Equal(t, data, decompressed, codec)
+		}
+	}
+
+	// Assert Errors
+	compressed, err := Compress(data, Zstd)
+	assert.NoError(t, err)
+	_, err = Decompress(compressed, Zstd, Options{MaxSize: 100})
+	assert.ErrorIs(t, err, ErrTooLarge)
+	decompressed, err := Decompress(compressed, Zstd, Options{MaxSize: int64(len(data))})
+	assert.NoError(t, err)
+	assert.Equal(t, data, decompressed)
+
+	_, err = Compress(data, "brotli")
+	assert.Error(t, err)
+	_, err = Decompress([]byte("not gzip"), Gzip)
+	assert.Error(t, err)
+}
+
+// TestStream is a unit test for compress.NewWriter() and compress.NewReader()
+func TestStream(t *testing.T) {
+	var buffer bytes.Buffer
+	w, err := NewWriter(&buffer, Gzip)
+	assert.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		_, err = io.WriteString(w, "payload ")
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+
+	r, err := NewReader(&buffer, Gzip)
+	// Assert Unit Test
+	assert.NoError(t, err)
+	data, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Close())
+	assert.Equal(t, bytes.Repeat([]byte("payload "), 100), data)
+
+	_, err = NewReader(&buffer, "lz4")
+	assert.Error(t, err)
+}
@@ -0,0 +1,99 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/config
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package config provides a uniform api for loading configuration from files, environment variables and defaults
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Source is a layer of configuration values merged by Load (e.g. File, Env or Defaults)
+type Source interface {
+	// Name describes the source in errors (e.g. "file 'app.yaml'")
+	Name() string
+
+	// Values returns the configuration values of the source as a tree of
+	// map[string]interface{}, []interface{} and scalar values, t is the type of the
+	// configuration struct (e.g. to find the environment variables of its fields)
+	Values(t reflect.Type) (map[string]interface{}, error)
+}
+
+// Load simply loads configuration into cfg (a pointer to a struct) from sources merged
+// in order, each source overriding the values of the sources before it. Pass the
+// sources from lowest to highest precedence, e.g.
+//
+//	config.Load(&cfg, config.Defaults(defaults), config.File("app.yaml"), config.Env("APP"))
+//
+// loads the defaults, overridden by the file, overridden by APP_* environment variables.
+// Maps (and nested structs) are merged key by key, lists and other values are replaced.
+// Fields not set by any source keep their current value, or take the value of their
+// `default:"value"` tag. References such as ${env:TOKEN} or ${file:/run/secrets/db} in
+// string values are resolved by their Resolver (see RegisterResolver), errors about a
+// resolved value leave the value out.
+//
+// Keys are matched to fields by their `config:"name"` tag, or by the field name ignoring
+// case, "_" and "-" (MaxConns matches max_conns, maxConns and max-conns). The loaded
+// configuration is checked against the validation tags of its fields (see Validate).
+// Unknown keys, values that don't fit their field and invalid values are reported
+// together in one error, each value as a FieldError (e.g. "server.port must be 1-65535").
+func Load(cfg interface{}, sources ...Source) error {
+
+	// Validate Configuration Parameter
+	value := reflect.ValueOf(cfg)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("The 'cfg' parameter must be a pointer to a struct. A struct is required to load Configuration")
+	}
+	t := value.Elem().Type()
+
+	// Merge Sources (over the values of default tags)
+	var errs []error
+	merged := defaultTree(t)
+	for _, source := range sources {
+		values, err := source.Values(t)
+		if err != nil {
+			return err
+		}
+		tree, unknown := canonicalTree(values, t, "", source.Name())
+		errs = append(errs, unknown...)
+		merged = mergeTrees(merged, tree)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	// Resolve References
+	referenced := map[string]bool{}
+	resolved, errs := resolveValue(merged, "", referenced)
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	// Decode Merged Values
+	errs = decode(resolved, value.Elem(), "")
+
+	// Validate Configuration (skipping the values that failed to decode)
+	failed := map[string]bool{}
+	for _, err := range errs {
+		if fieldErr, ok := err.(*FieldError); ok {
+			failed[fieldErr.Key] = true
+		}
+	}
+	errs = append(errs, validate(value.Elem(), "", failed)...)
+
+	// Leave Resolved Values out of Errors (references are often secrets)
+	for _, err := range errs {
+		if fieldErr, ok := err.(*FieldError); ok && referenced[fieldErr.Key] && fieldErr.redacted != "" {
+			fieldErr.Message = fieldErr.redacted
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,109 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/config
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testConfig is the configuration struct of the unit tests
+type testConfig struct {
+	Name    string
+	Debug   bool
+	Server  testServer
+	Tags    []string
+	Limits  map[string]int
+	Timeout time.Duration
+	Secret  string `config:"-"`
+}
+
+// testServer is a nested table of testConfig
+type testServer struct {
+	Host     string
+	Port     int
+	MaxConns int `config:"max-conns"`
+}
+
+// TestLoad is a unit test for config.Load() with files, environment variables and defaults
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"app.json": `{"name": "json", "server": {"port": 8080}, "tags": ["a", "b"], "limits": {"cpu": 2}}`,
+		"app.yaml": "name: yaml\nserver:\n  port: 8080\ntags: [a, b]\nlimits:\n  cpu: 2\n",
+		"app.toml": "name = \"toml\"\ntags = [\"a\", \"b\"]\n[server]\nport = 8080\n[limits]\ncpu = 2\n",
+	}
+	defaults := testConfig{Server: testServer{Host: "localhost", Port: 80, MaxConns: 10}, Limits: map[string]int{"memory": 512}, Timeout: 5 * time.Second}
+
+	for file, contents := range files {
+		path := filepath.Join(dir, file)
+		assert.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+
+		var cfg testConfig
+		err := Load(&cfg, Defaults(defaults), File(path))
+		// Assert Unit Test
+		assert.NoError(t, err, file)
+		assert.Equal(t, file[4:], cfg.Name)
+		assert.Equal(t, testServer{Host: "localhost", Port: 8080, MaxConns: 10}, cfg.Server, file)
+		assert.Equal(t, []string{"a", "b"}, cfg.Tags)
+		assert.Equal(t, map[string]int{"cpu": 2, "memory": 512}, cfg.Limits, file)
+		assert.Equal(t, 5*time.Second, cfg.Timeout)
+	}
+
+	// Assert Precedence of Environment Variables
+	t.Setenv("TEST_SERVER_PORT", "9090")
+	t.Setenv("TEST_SERVER_MAX_CONNS", "20")
+	t.Setenv("TEST_TAGS", "x, y")
+	t.Setenv("TEST_DEBUG", "true")
+	t.Setenv("TEST_TIMEOUT", "1m")
+	var cfg testConfig
+	assert.NoError(t, Load(&cfg, Defaults(defaults), File(filepath.Join(dir, "app.yaml")), Env("TEST")))
+	assert.Equal(t, 9090, cfg.Server.Port)
+	assert.Equal(t, 20, cfg.Server.MaxConns)
+	assert.Equal(t, []string{"x", "y"}, cfg.Tags)
+	assert.True(t, cfg.Debug)
+	assert.Equal(t, time.Minute, cfg.Timeout)
+	assert.Equal(t, "yaml", cfg.Name)
+
+	// Assert Optional Files
+	cfg = testConfig{Name: "kept"}
+	assert.NoError(t, Load(&cfg, OptionalFile(filepath.Join(dir, "missing.yaml"))))
+	assert.Equal(t, "kept", cfg.Name)
+}
+
+// TestLoadErrors is a unit test for the errors of config.Load()
+func TestLoadErrors(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("sever:\n  port: 1\nserver:\n  port: abc\n  host: [1]\nsecret: x\n"), 0644))
+
+	var cfg testConfig
+	err := Load(&cfg, File(path))
+	// Assert Unit Test
+	assert.ErrorContains(t, err, "Unknown configuration key 'sever'")
+	assert.ErrorContains(t, err, "Unknown configuration key 'secret'")
+
+	assert.NoError(t, os.WriteFile(path, []byte("server:\n  port: abc\n  host: [1]\ntimeout: soon\n"), 0644))
+	err = Load(&cfg, File(path))
+	assert.ErrorContains(t, err, "server.port must be an integer, got \"abc\"")
+	assert.ErrorContains(t, err, "server.host must be a string, got a list")
+	assert.ErrorContains(t, err, "timeout must be a duration")
+
+	assert.ErrorContains(t, Load(&cfg, File(filepath.Join(dir, "missing.yaml"))), "doesn't exist")
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "conf.d.yaml"), 0755))
+	err = Load(&cfg, File(filepath.Join(dir, "conf.d.yaml")))
+	assert.ErrorContains(t, err, "Unable to read file")
+	assert.NotContains(t, err.Error(), "doesn't exist")
+	assert.Error(t, Load(&cfg, File(filepath.Join(dir, "app.ini"))))
+	assert.Error(t, Load(cfg))
+}
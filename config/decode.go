@@ -0,0 +1,534 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/config
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	timeType            = reflect.TypeOf(time.Time{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// field is a configurable field of a configuration struct
+type field struct {
+	key   string
+	index []int
+	typ   reflect.Type
+	tag   reflect.StructTag
+}
+
+// structFields returns the configurable fields of a struct type: exported fields not
+// tagged `config:"-"`, with the fields of embedded structs promoted
+func structFields(t reflect.Type) []field {
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("config"), ",")[0]
+		if name == "-" || f.PkgPath != "" {
+			continue
+		}
+
+		// Promote Fields of Embedded Structs
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			for _, embedded := range structFields(f.Type) {
+				embedded.index = append([]int{i}, embedded.index...)
+				fields = append(fields, embedded)
+			}
+			continue
+		}
+		if name == "" {
+			name = snakeCase(f.Name)
+		}
+		fields = append(fields, field{key: name, index: []int{i}, typ: f.Type, tag: f.Tag})
+	}
+	return fields
+}
+
+// findField returns the field of a struct type matching a configuration key
+func findField(t reflect.Type, key string) (field, bool) {
+	normal := normalKey(key)
+	for _, f := range structFields(t) {
+		if normalKey(f.key) == normal {
+			return f, true
+		}
+	}
+	return field{}, false
+}
+
+// normalKey returns a key in lower case without "_" and "-" for matching keys to fields
+func normalKey(key string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+}
+
+// snakeCase returns a field name in snake case (MaxConns to max_conns, HTTPPort to http_port)
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			previous := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// joinKey returns the dotted key of a nested value (e.g. server.port)
+func joinKey(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// indirectType returns the type a pointer type points to
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// isTable reports whether values of a type are decoded from a table of keys (structs
+// and maps, except types decoded from a single value such as time.Time)
+func isTable(t reflect.Type) bool {
+	if t == timeType || reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		return false
+	}
+	return t.Kind() == reflect.Struct || t.Kind() == reflect.Map
+}
+
+// canonicalTree returns the values of a source with the keys of struct fields replaced
+// by their configuration keys, so the values of all sources can be merged, and an error
+// for each key without a field (named by the source)
+func canonicalTree(values map[string]interface{}, t reflect.Type, path string, source string) (map[string]interface{}, []error) {
+	var errs []error
+	tree := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		f, ok := findField(t, key)
+		if !ok {
+			errs = append(errs, fmt.Errorf("Unknown configuration key '%v' in %v", joinKey(path, key), source))
+			continue
+		}
+		var unknown []error
+		tree[f.key], unknown = canonicalValue(value, f.typ, joinKey(path, f.key), source)
+		errs = append(errs, unknown...)
+	}
+	return tree, errs
+}
+
+// canonicalValue returns a value with the keys of the struct fields below it replaced
+// by their configuration keys
+func canonicalValue(value interface{}, t reflect.Type, path string, source string) (interface{}, []error) {
+	t = indirectType(t)
+	switch {
+	case !isTable(t) && t.Kind() != reflect.Slice:
+		return value, nil
+	case t.Kind() == reflect.Struct:
+		if table, ok := value.(map[string]interface{}); ok {
+			return canonicalTree(table, t, path, source)
+		}
+	case t.Kind() == reflect.Map:
+		if table, ok := value.(map[string]interface{}); ok {
+			var errs []error
+			values := make(map[string]interface{}, len(table))
+			for key, item := range table {
+				var unknown []error
+				values[key], unknown = canonicalValue(item, t.Elem(), joinKey(path, key), source)
+				errs = append(errs, unknown...)
+			}
+			return values, errs
+		}
+	case t.Kind() == reflect.Slice:
+		if list, ok := value.([]interface{}); ok {
+			var errs []error
+			values := make([]interface{}, len(list))
+			for i, item := range list {
+				var unknown []error
+				values[i], unknown = canonicalValue(item, t.Elem(), fmt.Sprintf("%v[%v]", path, i), source)
+				errs = append(errs, unknown...)
+			}
+			return values, errs
+		}
+	}
+	return value, nil
+}
+
+// mergeTrees returns the values of base overridden by the values of override, tables
+// are merged key by key and other values are replaced
+func mergeTrees(base map[string]interface{}, override map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base)+len(override))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range override {
+		baseTable, baseOK := merged[key].(map[string]interface{})
+		table, ok := value.(map[string]interface{})
+		if baseOK && ok {
+			merged[key] = mergeTrees(baseTable, table)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
+
+// decode sets v from a merged value, returning an error for each value that doesn't
+// fit its field
+func decode(value interface{}, v reflect.Value, path string) []error {
+	if value == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	t := v.Type()
+
+	// Allocate Pointers
+	if t.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(t.Elem()))
+		}
+		return decode(value, v.Elem(), path)
+	}
+
+	// Set Values of the same Type (e.g. from Defaults)
+	if rv := reflect.ValueOf(value); rv.Type() == t && !isTable(t) {
+		v.Set(rv)
+		return nil
+	}
+
+	switch {
+	case t.Kind() == reflect.Struct && isTable(t):
+		table, ok := value.(map[string]interface{})
+		if !ok {
+			return []error{valueError(path, describe(value), "must be a table of keys")}
+		}
+		var errs []error
+		for _, f := range structFields(t) {
+			if item, ok := table[f.key]; ok {
+				errs = append(errs, decode(item, fieldByIndex(v, f.index), joinKey(path, f.key))...)
+			}
+		}
+		return errs
+	case t.Kind() == reflect.Map && isTable(t):
+		table, ok := value.(map[string]interface{})
+		if !ok {
+			return []error{valueError(path, describe(value), "must be a table of keys")}
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMapWithSize(t, len(table)))
+		}
+		var errs []error
+		for key, item := range table {
+			mapKey := reflect.New(t.Key()).Elem()
+			err := setScalar(key, mapKey, joinKey(path, key))
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			elem := reflect.New(t.Elem()).Elem()
+			if existing := v.MapIndex(mapKey); existing.IsValid() {
+				elem.Set(existing)
+			}
+			itemErrs := decode(item, elem, joinKey(path, key))
+			errs = append(errs, itemErrs...)
+			if len(itemErrs) == 0 {
+				v.SetMapIndex(mapKey, elem)
+			}
+		}
+		return errs
+	case (t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8) || t.Kind() == reflect.Array:
+		list, ok := value.([]interface{})
+		if !ok {
+			return []error{valueError(path, describe(value), "must be a list")}
+		}
+		if t.Kind() == reflect.Array && len(list) != t.Len() {
+			return []error{fieldError(path, "must be a list of %v values, got %v", t.Len(), len(list))}
+		}
+		items := v
+		if t.Kind() == reflect.Slice {
+			items = reflect.MakeSlice(t, len(list), len(list))
+		}
+		var errs []error
+		for i, item := range list {
+			errs = append(errs, decode(item, items.Index(i), fmt.Sprintf("%v[%v]", path, i))...)
+		}
+		v.Set(items)
+		return errs
+	case t.Kind() == reflect.Interface:
+		v.Set(reflect.ValueOf(value))
+		return nil
+	}
+
+	err := setScalar(value, v, path)
+	if err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// fieldByIndex returns the field of a struct value by its index path
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		v = v.Field(i)
+	}
+	return v
+}
+
+// setScalar sets a string, bool, number, duration, time or encoding.TextUnmarshaler
+// from a value, converting strings (e.g. from environment variables) and numbers
+func setScalar(value interface{}, v reflect.Value, path string) error {
+	t := v.Type()
+
+	// Decode Text Values (e.g. net.IP or custom types)
+	if text, ok := value.(string); ok && v.CanAddr() && reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		err := v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text))
+		if err != nil {
+			return &FieldError{Key: describeKey(path), Message: fmt.Sprintf("is invalid: %v", err), redacted: "is invalid" + resolvedNote}
+		}
+		return nil
+	}
+
+	switch {
+	case t == durationType:
+		if text, ok := value.(string); ok {
+			duration, err := time.ParseDuration(strings.TrimSpace(text))
+			if err != nil {
+				return valueError(path, describe(value), "must be a duration (e.g. \"30s\")")
+			}
+			v.SetInt(int64(duration))
+			return nil
+		}
+	case t == timeType:
+		if text, ok := value.(string); ok {
+			parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(text))
+			if err != nil {
+				return valueError(path, describe(value), "must be a RFC 3339 time (e.g. \"2020-01-02T15:04:05Z\")")
+			}
+			v.Set(reflect.ValueOf(parsed))
+			return nil
+		}
+		if parsed, ok := value.(time.Time); ok {
+			v.Set(reflect.ValueOf(parsed))
+			return nil
+		}
+		return valueError(path, describe(value), "must be a RFC 3339 time (e.g. \"2020-01-02T15:04:05Z\")")
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		switch value := value.(type) {
+		case string:
+			v.SetString(value)
+		case bool, int, int64, uint64, float64, json.Number:
+			v.SetString(fmt.Sprint(value))
+		default:
+			return valueError(path, describe(value), "must be a string")
+		}
+	case reflect.Bool:
+		switch value := value.(type) {
+		case bool:
+			v.SetBool(value)
+		case string:
+			parsed, err := strconv.ParseBool(strings.TrimSpace(value))
+			if err != nil {
+				return valueError(path, describe(value), "must be true or false")
+			}
+			v.SetBool(parsed)
+		default:
+			return valueError(path, describe(value), "must be true or false")
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, ok := toInt(value)
+		if !ok || v.OverflowInt(number) {
+			return valueError(path, describe(value), "must be an integer")
+		}
+		v.SetInt(number)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, ok := toInt(value)
+		if !ok || number < 0 || v.OverflowUint(uint64(number)) {
+			return valueError(path, describe(value), "must be a positive integer")
+		}
+		v.SetUint(uint64(number))
+	case reflect.Float32, reflect.Float64:
+		number, ok := toFloat(value)
+		if !ok || v.OverflowFloat(number) {
+			return valueError(path, describe(value), "must be a number")
+		}
+		v.SetFloat(number)
+	case reflect.Slice:
+		// Byte Slices are read from strings
+		text, ok := value.(string)
+		if !ok {
+			return valueError(path, describe(value), "must be a string")
+		}
+		v.SetBytes([]byte(text))
+	default:
+		return fieldError(path, "has an unsupported type %v", t)
+	}
+	return nil
+}
+
+// toInt converts a decoded number (or a string) to an integer
+func toInt(value interface{}) (int64, bool) {
+	switch value := value.(type) {
+	case int:
+		return int64(value), true
+	case int64:
+		return value, true
+	case uint64:
+		return int64(value), value <= math.MaxInt64
+	case float64:
+		return int64(value), value == math.Trunc(value) && math.Abs(value) < math.MaxInt64
+	case json.Number:
+		number, err := value.Int64()
+		return number, err == nil
+	case string:
+		number, err := strconv.ParseInt(strings.TrimSpace(value), 0, 64)
+		return number, err == nil
+	}
+	return 0, false
+}
+
+// toFloat converts a decoded number (or a string) to a float
+func toFloat(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case int:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case uint64:
+		return float64(value), true
+	case float64:
+		return value, true
+	case json.Number:
+		number, err := value.Float64()
+		return number, err == nil
+	case string:
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		return number, err == nil
+	}
+	return 0, false
+}
+
+// FieldError is an invalid configuration value, Load returns every FieldError joined
+// into one error
+type FieldError struct {
+	// Key is the dotted key of the value (e.g. server.port)
+	Key string
+
+	// Message describes the problem (e.g. "must be 1-65535")
+	Message string
+
+	// redacted is the Message without the value, used when the value was resolved
+	// from a reference
+	redacted string
+}
+
+// Error returns the key followed by the message (e.g. "server.port must be 1-65535")
+func (e *FieldError) Error() string {
+	return e.Key + " " + e.Message
+}
+
+// fieldError returns a FieldError for the value at path
+func fieldError(path string, format string, args ...interface{}) error {
+	return &FieldError{Key: describeKey(path), Message: fmt.Sprintf(format, args...)}
+}
+
+// resolvedNote replaces the value in the errors about a value resolved from a reference
+const resolvedNote = " (the value was resolved from a reference)"
+
+// valueError returns a FieldError for the value at path followed by the value got
+// (e.g. "must be an integer, got \"abc\""), which is left out when it was resolved
+// from a reference
+func valueError(path string, got string, format string, args ...interface{}) error {
+	message := fmt.Sprintf(format, args...)
+	return &FieldError{
+		Key:      describeKey(path),
+		Message:  message + ", got " + got,
+		redacted: message + resolvedNote,
+	}
+}
+
+// describeKey returns the key of a value for errors ("configuration" for the root)
+func describeKey(path string) string {
+	if path == "" {
+		return "configuration"
+	}
+	return path
+}
+
+// describe returns a decoded value for errors
+func describe(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return strconv.Quote(value)
+	case map[string]interface{}:
+		return "a table"
+	case []interface{}:
+		return "a list"
+	}
+	return fmt.Sprint(value)
+}
+
+// encode returns a value as a tree of map[string]interface{}, []interface{} and scalar
+// values keyed by configuration keys (nil pointers are left out)
+func encode(v reflect.Value) interface{} {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	t := v.Type()
+	switch {
+	case t.Kind() == reflect.Struct && isTable(t):
+		table := map[string]interface{}{}
+		for _, f := range structFields(t) {
+			if value := encode(fieldByIndex(v, f.index)); value != nil {
+				table[f.key] = value
+			}
+		}
+		return table
+	case t.Kind() == reflect.Map && isTable(t):
+		if v.IsNil() {
+			return nil
+		}
+		table := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			table[fmt.Sprint(iter.Key().Interface())] = encode(iter.Value())
+		}
+		return table
+	case (t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8) || t.Kind() == reflect.Array:
+		if t.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		list := make([]interface{}, v.Len())
+		for i := range list {
+			list[i] = encode(v.Index(i))
+		}
+		return list
+	}
+	return v.Interface()
+}
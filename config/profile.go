@@ -0,0 +1,235 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/config
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// ProfileEnv is the environment variable selecting the profile when none is passed to Profile
+const ProfileEnv = "GOGO_PROFILE"
+
+// ProfileOptions configure Profile
+type ProfileOptions struct {
+	// Key is the key of the table of profiles in a single file (default "profiles")
+	Key string
+
+	// AppendLists appends the lists of a profile to the lists of the base configuration
+	// instead of replacing them
+	AppendLists bool
+
+	// ReplaceTables replaces the tables (maps and nested structs) of the base
+	// configuration with the tables of a profile instead of merging them key by key
+	ReplaceTables bool
+}
+
+// profileSource is a Source of a base configuration overlaid with profiles
+type profileSource struct {
+	path     string
+	options  ProfileOptions
+	selected []string
+}
+
+// Profile returns a Source reading a base configuration overlaid with named profiles
+// (e.g. dev, staging or prod), either from a single file whose "profiles" table holds
+// the overlay of each profile
+//
+//	server:
+//	  port: 80
+//	profiles:
+//	  dev:
+//	    server:
+//	      port: 8080
+//
+// or from a directory holding base.yaml and a file for each profile (dev.yaml, prod.toml,
+// any supported format). The profile is the profile parameter, or the GOGO_PROFILE
+// environment variable when it is empty, and several profiles may be applied in order
+// separated by commas ("prod,eu"). With no profile only the base configuration is read,
+// a profile that doesn't exist fails loading. Tables of a profile are merged into the
+// base key by key and lists are replaced, unless configured otherwise by ProfileOptions.
+func Profile(path string, profile string, options ...ProfileOptions) Source {
+
+	// Apply Profile Options
+	profileOptions := ProfileOptions{}
+	if len(options) > 0 {
+		profileOptions = options[0]
+	}
+	if profileOptions.Key == "" {
+		profileOptions.Key = "profiles"
+	}
+
+	// Select Profiles
+	if profile == "" {
+		profile = os.Getenv(ProfileEnv)
+	}
+	var selected []string
+	for _, name := range strings.Split(profile, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			selected = append(selected, name)
+		}
+	}
+
+	return profileSource{path: path, options: profileOptions, selected: selected}
+}
+
+// Name describes the profiles in errors
+func (s profileSource) Name() string {
+	if len(s.selected) == 0 {
+		return fmt.Sprintf("file '%v'", s.path)
+	}
+	return fmt.Sprintf("file '%v' (profile %v)", s.path, strings.Join(s.selected, ", "))
+}
+
+// Values reads the base configuration and overlays the selected profiles
+func (s profileSource) Values(t reflect.Type) (map[string]interface{}, error) {
+
+	// Check IF Path Exists
+	info, err := os.Stat(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("Path '%v' doesn't exist", s.path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read path '%v': %w", s.path, err)
+	}
+
+	// Read Base Configuration and Profiles
+	var base map[string]interface{}
+	var overlays map[string]map[string]interface{}
+	if info.IsDir() {
+		base, overlays, err = s.readDir()
+	} else {
+		base, overlays, err = s.readFile()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Overlay Profiles (keys are canonical so spellings of a key are merged)
+	var errs []error
+	tree, unknown := canonicalTree(base, t, "", s.Name())
+	errs = append(errs, unknown...)
+	for _, name := range s.selected {
+		overlay, ok := overlays[name]
+		if !ok {
+			return nil, fmt.Errorf("Profile '%v' was not found in '%v'", name, s.path)
+		}
+		overlay, unknown = canonicalTree(overlay, t, "", s.Name())
+		errs = append(errs, unknown...)
+		tree = overlayTrees(tree, overlay, s.options)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return tree, nil
+}
+
+// readFile reads the base configuration and the table of profiles of a single file
+func (s profileSource) readFile() (map[string]interface{}, map[string]map[string]interface{}, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("File '%v' doesn't exist", s.path)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("Unable to read file '%v': %w", s.path, err)
+	}
+	base, err := decodeFile(s.path, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Split Profiles from Base Configuration
+	overlays := map[string]map[string]interface{}{}
+	if base[s.options.Key] == nil {
+		return base, overlays, nil
+	}
+	profiles, ok := base[s.options.Key].(map[string]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("File '%v' has a '%v' key that is not a table of profiles", s.path, s.options.Key)
+	}
+	for name, profile := range profiles {
+		overlay, ok := profile.(map[string]interface{})
+		if !ok && profile != nil {
+			return nil, nil, fmt.Errorf("Profile '%v' in file '%v' is not a table of configuration keys", name, s.path)
+		}
+		overlays[name] = overlay
+	}
+	delete(base, s.options.Key)
+	return base, overlays, nil
+}
+
+// readDir reads the base configuration and profiles of a directory, the files named
+// base and after each profile with a supported extension
+func (s profileSource) readDir() (map[string]interface{}, map[string]map[string]interface{}, error) {
+	entries, err := os.ReadDir(s.path)
+	if err != nil {
+		return nil, nil, err
+	}
+	var base map[string]interface{}
+	overlays := map[string]map[string]interface{}{}
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".json" && ext != ".yaml" && ext != ".yml" && ext != ".toml") {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		path := filepath.Join(s.path, entry.Name())
+
+		// Check for Duplicate Profiles (e.g. dev.yaml and dev.toml)
+		if _, ok := overlays[name]; ok || (name == "base" && base != nil) {
+			return nil, nil, fmt.Errorf("Directory '%v' has more than one '%v' file", s.path, name)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		values, err := decodeFile(path, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if values == nil {
+			values = map[string]interface{}{}
+		}
+		if name == "base" {
+			base = values
+			continue
+		}
+		overlays[name] = values
+	}
+	return base, overlays, nil
+}
+
+// overlayTrees returns the values of base overlaid with the values of a profile, tables
+// are merged key by key and lists replaced unless configured otherwise
+func overlayTrees(base map[string]interface{}, overlay map[string]interface{}, options ProfileOptions) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base)+len(overlay))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range overlay {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			if baseTable, ok := merged[key].(map[string]interface{}); ok && !options.ReplaceTables {
+				merged[key] = overlayTrees(baseTable, value, options)
+				continue
+			}
+		case []interface{}:
+			if baseList, ok := merged[key].([]interface{}); ok && options.AppendLists {
+				merged[key] = append(append([]interface{}{}, baseList...), value...)
+				continue
+			}
+		}
+		merged[key] = value
+	}
+	return merged
+}
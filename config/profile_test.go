@@ -0,0 +1,65 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/config
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestProfile is a unit test for config.Profile() with a single file and a directory
+func TestProfile(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "app.yaml")
+	assert.NoError(t, os.WriteFile(file, []byte("name: base\ntags: [a]\nserver:\n  host: localhost\n  port: 80\nprofiles:\n  dev:\n    tags: [b]\n    server:\n      max_conns: 5\n  prod:\n    name: prod\n    server:\n      port: 443\n"), 0644))
+	profiles := filepath.Join(dir, "profiles")
+	assert.NoError(t, os.Mkdir(profiles, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(profiles, "base.yaml"), []byte("name: base\ntags: [a]\nserver:\n  host: localhost\n  port: 80\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(profiles, "dev.json"), []byte(`{"tags": ["b"], "server": {"maxConns": 5}}`), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(profiles, "prod.toml"), []byte("name = \"prod\"\n[server]\nport = 443\n"), 0644))
+
+	for _, path := range []string{file, profiles} {
+		// Assert Base Configuration
+		var cfg testConfig
+		assert.NoError(t, Load(&cfg, Profile(path, "")), path)
+		assert.Equal(t, testConfig{Name: "base", Tags: []string{"a"}, Server: testServer{Host: "localhost", Port: 80}}, cfg)
+
+		// Assert Profiles Merge Tables and Replace Lists
+		cfg = testConfig{}
+		assert.NoError(t, Load(&cfg, Profile(path, "dev")), path)
+		assert.Equal(t, testConfig{Name: "base", Tags: []string{"b"}, Server: testServer{Host: "localhost", Port: 80, MaxConns: 5}}, cfg)
+
+		// Assert Profile Options and Environment Variable
+		t.Setenv(ProfileEnv, "prod, dev")
+		cfg = testConfig{}
+		assert.NoError(t, Load(&cfg, Profile(path, "", ProfileOptions{AppendLists: true})), path)
+		assert.Equal(t, testConfig{Name: "prod", Tags: []string{"a", "b"}, Server: testServer{Host: "localhost", Port: 443, MaxConns: 5}}, cfg)
+		cfg = testConfig{}
+		assert.NoError(t, Load(&cfg, Profile(path, "prod", ProfileOptions{ReplaceTables: true})), path)
+		assert.Equal(t, testConfig{Name: "prod", Tags: []string{"a"}, Server: testServer{Port: 443}}, cfg)
+		os.Unsetenv(ProfileEnv)
+
+		assert.ErrorContains(t, Load(&cfg, Profile(path, "qa")), "Profile 'qa' was not found", path)
+	}
+
+	// Assert only a Missing Path doesn't exist
+	var cfg testConfig
+	assert.ErrorContains(t, Load(&cfg, Profile(filepath.Join(dir, "missing.yaml"), "")), "doesn't exist")
+	if runtime.GOOS != "windows" {
+		err := Load(&cfg, Profile(filepath.Join(file, "child.yaml"), ""))
+		assert.True(t, errors.Is(err, syscall.ENOTDIR), err)
+		assert.NotContains(t, err.Error(), "doesn't exist")
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/config
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Resolver returns the value of a reference in a configuration value, e.g. the value
+// of the environment variable TOKEN for ${env:TOKEN}
+type Resolver func(ref string) (string, error)
+
+// resolvers are the registered Resolvers by scheme
+var resolvers = struct {
+	sync.RWMutex
+	schemes map[string]Resolver
+}{schemes: map[string]Resolver{"env": EnvResolver, "file": FileResolver}}
+
+// referencePattern matches the ${scheme:ref} references in a string, and $${ escapes
+var referencePattern = regexp.MustCompile(`\$?\$\{([a-zA-Z][a-zA-Z0-9_-]*):([^}]*)\}`)
+
+// RegisterResolver registers a Resolver for the references ${scheme:ref} in string
+// values, which Load replaces with the value it returns, so secrets are read when the
+// configuration is loaded instead of living in configuration files (e.g. ${vault:db/password}).
+// The env and file schemes are registered by default, exec is not (register ExecResolver
+// to run commands from configuration files). Registering a scheme again replaces its Resolver.
+func RegisterResolver(scheme string, resolver Resolver) {
+	resolvers.Lock()
+	defer resolvers.Unlock()
+	if resolver == nil {
+		delete(resolvers.schemes, scheme)
+		return
+	}
+	resolvers.schemes[scheme] = resolver
+}
+
+// EnvResolver simply resolves ${env:NAME} to the value of an environment variable, which must be set
+func EnvResolver(ref string) (string, error) {
+	value, ok := os.LookupEnv(ref)
+	if !ok {
+		return "", fmt.Errorf("Environment variable '%v' is not set", ref)
+	}
+	return value, nil
+}
+
+// FileResolver simply resolves ${file:/run/secrets/db} to the contents of a file without
+// its trailing newline (e.g. Docker and Kubernetes secrets)
+func FileResolver(ref string) (string, error) {
+	data, err := os.ReadFile(ref)
+	if err != nil {
+		return "", fmt.Errorf("File '%v' could not be read", ref)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// ExecResolver simply resolves ${exec:op read op://vault/db/password} to the output of
+// a command without its trailing newline. The command is split on spaces and run
+// without a shell.
+func ExecResolver(ref string) (string, error) {
+	args := strings.Fields(ref)
+	if len(args) == 0 {
+		return "", fmt.Errorf("The command was empty")
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("Command '%v' failed: %w: %v", args[0], err, message)
+		}
+		return "", fmt.Errorf("Command '%v' failed: %w", args[0], err)
+	}
+	return strings.TrimRight(string(output), "\r\n"), nil
+}
+
+// resolveValue returns a merged value with the references in its strings resolved,
+// returning an error for each reference that could not be resolved (without the values
+// of other references, which may be secrets). The paths of resolved strings are added
+// to referenced, so errors about their values can leave the values out.
+func resolveValue(value interface{}, path string, referenced map[string]bool) (interface{}, []error) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		var errs []error
+		table := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			var unresolved []error
+			table[key], unresolved = resolveValue(item, joinKey(path, key), referenced)
+			errs = append(errs, unresolved...)
+		}
+		return table, errs
+	case []interface{}:
+		var errs []error
+		list := make([]interface{}, len(value))
+		for i, item := range value {
+			var unresolved []error
+			list[i], unresolved = resolveValue(item, fmt.Sprintf("%v[%v]", path, i), referenced)
+			errs = append(errs, unresolved...)
+		}
+		return list, errs
+	case string:
+		if !strings.Contains(value, "${") {
+			return value, nil
+		}
+		var errs []error
+		resolvers.RLock()
+		defer resolvers.RUnlock()
+		resolved := referencePattern.ReplaceAllStringFunc(value, func(reference string) string {
+			if strings.HasPrefix(reference, "$$") {
+				return reference[1:]
+			}
+			match := referencePattern.FindStringSubmatch(reference)
+			resolver, ok := resolvers.schemes[match[1]]
+			if !ok {
+				errs = append(errs, fieldError(path, "uses an unknown resolver '%v'", match[1]))
+				return reference
+			}
+			resolvedValue, err := resolver(match[2])
+			if err != nil {
+				errs = append(errs, fieldError(path, "could not be resolved: %v", err))
+				return reference
+			}
+			referenced[path] = true
+			return resolvedValue
+		})
+		return resolved, errs
+	}
+	return value, nil
+}
@@ -0,0 +1,74 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/config
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestResolvers is a unit test for the references resolved by config.Load()
+func TestResolvers(t *testing.T) {
+	dir := t.TempDir()
+	secret := filepath.Join(dir, "secret")
+	assert.NoError(t, os.WriteFile(secret, []byte("s3cret\n"), 0600))
+	t.Setenv("TEST_PORT", "9090")
+	RegisterResolver("test", func(ref string) (string, error) { return "resolved-" + ref, nil })
+	defer RegisterResolver("test", nil)
+
+	// Assert Unit Test
+	var cfg testConfig
+	assert.NoError(t, Load(&cfg, Defaults(map[string]interface{}{
+		"name":   "${file:" + secret + "}",
+		"server": map[string]interface{}{"port": "${env:TEST_PORT}", "host": "db-${test:a}.local"},
+		"tags":   []interface{}{"${test:b}", "$${env:HOME}"},
+	})))
+	assert.Equal(t, "s3cret", cfg.Name)
+	assert.Equal(t, 9090, cfg.Server.Port)
+	assert.Equal(t, "db-resolved-a.local", cfg.Server.Host)
+	assert.Equal(t, []string{"resolved-b", "${env:HOME}"}, cfg.Tags)
+
+	// Assert Unresolved References
+	err := Load(&cfg, Defaults(map[string]interface{}{"name": "${env:TEST_MISSING}", "tags": []interface{}{"${vault:db}"}}))
+	assert.ErrorContains(t, err, "name could not be resolved: Environment variable 'TEST_MISSING' is not set")
+	assert.ErrorContains(t, err, "tags[0] uses an unknown resolver 'vault'")
+}
+
+// TestResolversRedacted is a unit test for config.Load() leaving resolved values out of errors
+func TestResolversRedacted(t *testing.T) {
+	dir := t.TempDir()
+	secret := filepath.Join(dir, "secret")
+	assert.NoError(t, os.WriteFile(secret, []byte("Hunter2-s3cret\n"), 0600))
+	t.Setenv("TEST_SECRET", "Hunter2-s3cret")
+
+	// Assert Unit Test
+	var cfg validConfig
+	err := Load(&cfg, Defaults(map[string]interface{}{
+		"name":   "${file:" + secret + "}",
+		"level":  "${env:TEST_SECRET}",
+		"server": map[string]interface{}{"port": "${env:TEST_SECRET}", "timeout": "pre-${env:TEST_SECRET}"},
+	}))
+	assert.ErrorContains(t, err, "name must match ^[a-z][a-z0-9-]*$ (the value was resolved from a reference)")
+	assert.ErrorContains(t, err, "level must be one of debug, info, warn, error (the value was resolved from a reference)")
+	assert.ErrorContains(t, err, "server.port must be an integer (the value was resolved from a reference)")
+	assert.ErrorContains(t, err, "server.timeout must be a duration")
+	assert.NotContains(t, err.Error(), "Hunter2")
+	var addr struct{ Addr net.IP }
+	err = Load(&addr, Defaults(map[string]interface{}{"addr": "${env:TEST_SECRET}"}))
+	assert.EqualError(t, err, "addr is invalid (the value was resolved from a reference)")
+
+	// Assert Values without References are Reported
+	err = Load(&cfg, Defaults(map[string]interface{}{"name": "Hunter2", "server": map[string]interface{}{"port": "${env:TEST_SECRET}"}}))
+	assert.ErrorContains(t, err, "name must match ^[a-z][a-z0-9-]*$, got \"Hunter2\"")
+	assert.NotContains(t, err.Error(), "s3cret")
+}
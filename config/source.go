@@ -0,0 +1,226 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/config
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// fileSource is a configuration file Source
+type fileSource struct {
+	path     string
+	optional bool
+}
+
+// File returns a Source reading a configuration file, its format is chosen by the
+// extension (.json, .yaml, .yml or .toml). Loading fails if the file doesn't exist.
+func File(path string) Source {
+	return fileSource{path: path}
+}
+
+// OptionalFile returns a Source reading a configuration file like File, a missing
+// file is skipped (e.g. a per-user override file)
+func OptionalFile(path string) Source {
+	return fileSource{path: path, optional: true}
+}
+
+// Name describes the file in errors
+func (s fileSource) Name() string {
+	return fmt.Sprintf("file '%v'", s.path)
+}
+
+// Values reads and decodes the configuration file
+func (s fileSource) Values(t reflect.Type) (map[string]interface{}, error) {
+
+	// Read File
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) && s.optional {
+		return nil, nil
+	}
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("File '%v' doesn't exist", s.path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read file '%v': %w", s.path, err)
+	}
+
+	return decodeFile(s.path, data)
+}
+
+// decodeFile decodes the contents of a configuration file by the format of its extension
+func decodeFile(path string, data []byte) (map[string]interface{}, error) {
+	var values interface{}
+	var format string
+	var err error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		format = "JSON"
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		err = decoder.Decode(&values)
+	case ".yaml", ".yml":
+		format = "YAML"
+		err = yaml.Unmarshal(data, &values)
+	case ".toml":
+		format = "TOML"
+		var table map[string]interface{}
+		err = toml.Unmarshal(data, &table)
+		values = table
+	default:
+		return nil, fmt.Errorf("File '%v' has an unsupported configuration format (use .json, .yaml, .yml or .toml)", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("File '%v' is not valid %v: %v", path, format, err)
+	}
+
+	// Check Top Level Table
+	if values == nil {
+		return nil, nil
+	}
+	table, ok := plainValue(values).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("File '%v' is not a %v table of configuration keys", path, format)
+	}
+	return table, nil
+}
+
+// plainValue converts the maps and lists decoded from YAML and TOML into
+// map[string]interface{} and []interface{}
+func plainValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			value[key] = plainValue(item)
+		}
+		return value
+	case map[interface{}]interface{}:
+		table := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			table[fmt.Sprint(key)] = plainValue(item)
+		}
+		return table
+	case []map[string]interface{}:
+		list := make([]interface{}, len(value))
+		for i, item := range value {
+			list[i] = plainValue(item)
+		}
+		return list
+	case []interface{}:
+		for i, item := range value {
+			value[i] = plainValue(item)
+		}
+		return value
+	}
+	return value
+}
+
+// envSource is an environment variable Source
+type envSource struct {
+	prefix string
+}
+
+// Env returns a Source reading environment variables named after the configuration
+// keys of the fields in upper case, joined by "_" and prefixed with prefix (e.g.
+// APP_SERVER_PORT for the port key of the server table with prefix "APP"). Lists are
+// read as comma-separated values, maps are not read from the environment.
+func Env(prefix string) Source {
+	return envSource{prefix: strings.ToUpper(strings.TrimSuffix(prefix, "_"))}
+}
+
+// Name describes the environment variables in errors
+func (s envSource) Name() string {
+	if s.prefix == "" {
+		return "environment variables"
+	}
+	return fmt.Sprintf("environment variables '%v_*'", s.prefix)
+}
+
+// Values reads the environment variables of the fields of t
+func (s envSource) Values(t reflect.Type) (map[string]interface{}, error) {
+	return envValues(t, s.prefix), nil
+}
+
+// envValues returns the values of the environment variables of the fields of a struct
+// type, named with a prefix
+func envValues(t reflect.Type, prefix string) map[string]interface{} {
+	values := map[string]interface{}{}
+	for _, f := range structFields(t) {
+		name := strings.ToUpper(strings.ReplaceAll(f.key, "-", "_"))
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+
+		// Read Nested Tables
+		fieldType := indirectType(f.typ)
+		if isTable(fieldType) && fieldType.Kind() == reflect.Struct {
+			if nested := envValues(fieldType, name); len(nested) > 0 {
+				values[f.key] = nested
+			}
+			continue
+		}
+		if fieldType.Kind() == reflect.Map {
+			continue
+		}
+
+		// Read Variable (lists are comma-separated)
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if fieldType.Kind() == reflect.Slice && fieldType.Elem().Kind() != reflect.Uint8 {
+			var list []interface{}
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					list = append(list, item)
+				}
+			}
+			values[f.key] = list
+			continue
+		}
+		values[f.key] = value
+	}
+	return values
+}
+
+// defaultsSource is a Source of default values
+type defaultsSource struct {
+	defaults interface{}
+}
+
+// Defaults returns a Source of default values, either a configuration struct (or a
+// pointer to one) whose fields are all used, or a map of configuration keys
+func Defaults(defaults interface{}) Source {
+	return defaultsSource{defaults: defaults}
+}
+
+// Name describes the defaults in errors
+func (s defaultsSource) Name() string {
+	return "defaults"
+}
+
+// Values returns the default values as a tree
+func (s defaultsSource) Values(t reflect.Type) (map[string]interface{}, error) {
+	if s.defaults == nil {
+		return nil, nil
+	}
+	values, ok := encode(reflect.ValueOf(s.defaults)).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Defaults must be a struct or a map of configuration keys, got %T", s.defaults)
+	}
+	return values, nil
+}
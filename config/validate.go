@@ -0,0 +1,245 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/config
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Validate simply checks the fields of a configuration struct (or a pointer to one)
+// against their validation tags, returning every FieldError joined into one error.
+// Load validates the configuration it loads. The tags are
+//
+//	required:"true"         the field must not be empty (the zero value)
+//	min:"1" max:"65535"     the range of a number or duration, or the length of a string, list or map
+//	enum:"debug,info,warn"  the allowed values of a field (or of the items of a list)
+//	pattern:"^[a-z]+$"      a regular expression strings (or the items of a list) must match
+//
+// and `default:"value"` sets the value of a field when no source sets it (lists are
+// comma-separated).
+func Validate(cfg interface{}) error {
+	value := reflect.ValueOf(cfg)
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return fmt.Errorf("The 'cfg' parameter must be a struct. A struct is required to validate Configuration")
+	}
+	return errors.Join(validate(value, "", nil)...)
+}
+
+// defaultTree returns the values of the `default` tags of the fields of a struct type
+// as a tree keyed by configuration keys
+func defaultTree(t reflect.Type) map[string]interface{} {
+	tree := map[string]interface{}{}
+	for _, f := range structFields(t) {
+		fieldType := indirectType(f.typ)
+		value, ok := f.tag.Lookup("default")
+		switch {
+		case ok && fieldType.Kind() == reflect.Slice && fieldType.Elem().Kind() != reflect.Uint8:
+			list := []interface{}{}
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					list = append(list, item)
+				}
+			}
+			tree[f.key] = list
+		case ok:
+			tree[f.key] = value
+		case isTable(fieldType) && fieldType.Kind() == reflect.Struct:
+			if nested := defaultTree(fieldType); len(nested) > 0 {
+				tree[f.key] = nested
+			}
+		}
+	}
+	return tree
+}
+
+// validate checks the fields of a struct value against their validation tags, skipping
+// the keys that failed to decode
+func validate(v reflect.Value, path string, failed map[string]bool) []error {
+	var errs []error
+	for _, f := range structFields(v.Type()) {
+		key := joinKey(path, f.key)
+		if failed[key] {
+			continue
+		}
+		value := fieldByIndex(v, f.index)
+
+		// Check Required Fields
+		if f.tag.Get("required") == "true" && value.IsZero() {
+			errs = append(errs, fieldError(key, "is required"))
+			continue
+		}
+		for value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				break
+			}
+			value = value.Elem()
+		}
+		if value.Kind() == reflect.Ptr {
+			continue
+		}
+
+		errs = append(errs, checkRange(value, key, f.tag)...)
+		errs = append(errs, checkValues(value, key, f.tag)...)
+
+		// Validate Nested Tables
+		switch {
+		case isTable(value.Type()) && value.Kind() == reflect.Struct:
+			errs = append(errs, validate(value, key, failed)...)
+		case value.Kind() == reflect.Slice || value.Kind() == reflect.Array:
+			for i := 0; i < value.Len(); i++ {
+				if item := reflect.Indirect(value.Index(i)); item.Kind() == reflect.Struct && isTable(item.Type()) {
+					errs = append(errs, validate(item, fmt.Sprintf("%v[%v]", key, i), failed)...)
+				}
+			}
+		case value.Kind() == reflect.Map:
+			iter := value.MapRange()
+			for iter.Next() {
+				if item := reflect.Indirect(iter.Value()); item.Kind() == reflect.Struct && isTable(item.Type()) {
+					errs = append(errs, validate(item, joinKey(key, fmt.Sprint(iter.Key().Interface())), failed)...)
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// checkRange checks a value against the min and max tags of its field: the range of
+// a number or duration, or the length of a string, list or map
+func checkRange(value reflect.Value, key string, tag reflect.StructTag) []error {
+	minTag, hasMin := tag.Lookup("min")
+	maxTag, hasMax := tag.Lookup("max")
+	if !hasMin && !hasMax {
+		return nil
+	}
+
+	// Measure Value (numbers by value, strings, lists and maps by length)
+	var size float64
+	unit := ""
+	parse := func(limit string) (float64, error) { return strconv.ParseFloat(limit, 64) }
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		size = float64(value.Int())
+		if value.Type() == durationType {
+			parse = func(limit string) (float64, error) {
+				duration, err := time.ParseDuration(limit)
+				return float64(duration), err
+			}
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		size = float64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		size = value.Float()
+	case reflect.String:
+		size, unit = float64(utf8.RuneCountInString(value.String())), " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		size, unit = float64(value.Len()), " items"
+	default:
+		return []error{fieldError(key, "has min or max tags but is not a number, string, list or map")}
+	}
+
+	// Parse Limits
+	low, high := 0.0, 0.0
+	var err error
+	if hasMin {
+		if low, err = parse(minTag); err != nil {
+			return []error{fieldError(key, "has an invalid min tag '%v'", minTag)}
+		}
+	}
+	if hasMax {
+		if high, err = parse(maxTag); err != nil {
+			return []error{fieldError(key, "has an invalid max tag '%v'", maxTag)}
+		}
+	}
+
+	// Compare Value
+	if (!hasMin || size >= low) && (!hasMax || size <= high) {
+		return nil
+	}
+	got := fmt.Sprint(value.Interface())
+	if unit != "" {
+		got = strconv.FormatFloat(size, 'f', -1, 64) + unit
+	}
+	switch {
+	case hasMin && hasMax && unit != "":
+		return []error{valueError(key, got, "must have %v-%v%v", minTag, maxTag, unit)}
+	case hasMin && hasMax:
+		return []error{valueError(key, got, "must be %v-%v", minTag, maxTag)}
+	case hasMin && unit != "":
+		return []error{valueError(key, got, "must have at least %v%v", minTag, unit)}
+	case hasMin:
+		return []error{valueError(key, got, "must be at least %v", minTag)}
+	case unit != "":
+		return []error{valueError(key, got, "must have at most %v%v", maxTag, unit)}
+	}
+	return []error{valueError(key, got, "must be at most %v", maxTag)}
+}
+
+// checkValues checks a value (or the items of a list) against the enum and pattern
+// tags of its field
+func checkValues(value reflect.Value, key string, tag reflect.StructTag) []error {
+	enumTag, hasEnum := tag.Lookup("enum")
+	patternTag, hasPattern := tag.Lookup("pattern")
+	if !hasEnum && !hasPattern {
+		return nil
+	}
+	var pattern *regexp.Regexp
+	if hasPattern {
+		var err error
+		pattern, err = regexp.Compile(patternTag)
+		if err != nil {
+			return []error{fieldError(key, "has an invalid pattern tag '%v': %v", patternTag, err)}
+		}
+	}
+	var allowed []string
+	for _, item := range strings.Split(enumTag, ",") {
+		allowed = append(allowed, strings.TrimSpace(item))
+	}
+
+	// Check Value or List Items
+	items := []reflect.Value{value}
+	keys := []string{key}
+	if (value.Kind() == reflect.Slice && value.Type().Elem().Kind() != reflect.Uint8) || value.Kind() == reflect.Array {
+		items, keys = nil, nil
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, reflect.Indirect(value.Index(i)))
+			keys = append(keys, fmt.Sprintf("%v[%v]", key, i))
+		}
+	}
+	var errs []error
+	for i, item := range items {
+		text := fmt.Sprint(item.Interface())
+		if hasEnum && !contains(allowed, text) {
+			errs = append(errs, valueError(keys[i], strconv.Quote(text), "must be one of %v", strings.Join(allowed, ", ")))
+		}
+		if hasPattern && !pattern.MatchString(text) {
+			errs = append(errs, valueError(keys[i], strconv.Quote(text), "must match %v", patternTag))
+		}
+	}
+	return errs
+}
+
+// contains reports whether a list of strings contains a string
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
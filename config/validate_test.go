@@ -0,0 +1,76 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/config
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// validConfig is the configuration struct of the validation unit tests
+type validConfig struct {
+	Name   string   `required:"true" pattern:"^[a-z][a-z0-9-]*$"`
+	Level  string   `default:"info" enum:"debug,info,warn,error"`
+	Hosts  []string `default:"a, b" min:"1" max:"3"`
+	Server struct {
+		Port    int           `default:"8080" min:"1" max:"65535"`
+		Timeout time.Duration `default:"30s" max:"5m"`
+	}
+	Workers []validWorker
+}
+
+// validWorker is a list item of validConfig
+type validWorker struct {
+	Queue string `required:"true"`
+}
+
+// TestValidate is a unit test for config.Validate() and the tags checked by config.Load()
+func TestValidate(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.yaml")
+
+	// Assert Default Tags
+	assert.NoError(t, os.WriteFile(path, []byte("name: app\n"), 0644))
+	var cfg validConfig
+	assert.NoError(t, Load(&cfg, File(path)))
+	assert.Equal(t, "info", cfg.Level)
+	assert.Equal(t, []string{"a", "b"}, cfg.Hosts)
+	assert.Equal(t, 8080, cfg.Server.Port)
+	assert.Equal(t, 30*time.Second, cfg.Server.Timeout)
+
+	// Assert Aggregated Errors
+	assert.NoError(t, os.WriteFile(path, []byte("name: App\nlevel: trace\nhosts: []\nserver:\n  port: 70000\n  timeout: 1h\nworkers:\n  - queue: jobs\n  - {}\n"), 0644))
+	cfg = validConfig{}
+	err := Load(&cfg, File(path))
+	assert.ErrorContains(t, err, "name must match ^[a-z][a-z0-9-]*$, got \"App\"")
+	assert.ErrorContains(t, err, "level must be one of debug, info, warn, error, got \"trace\"")
+	assert.ErrorContains(t, err, "hosts must have 1-3 items, got 0 items")
+	assert.ErrorContains(t, err, "server.port must be 1-65535, got 70000")
+	assert.ErrorContains(t, err, "server.timeout must be at most 5m, got 1h0m0s")
+	assert.ErrorContains(t, err, "workers[1].queue is required")
+	var fieldErr *FieldError
+	assert.True(t, errors.As(err, &fieldErr))
+
+	// Assert Values that Failed to Decode are not Validated
+	assert.NoError(t, os.WriteFile(path, []byte("server:\n  port: abc\n"), 0644))
+	err = Load(&cfg, File(path))
+	assert.ErrorContains(t, err, "server.port must be an integer")
+	assert.NotContains(t, err.Error(), "server.port must be 1-65535")
+
+	// Assert Validate
+	cfg = validConfig{Level: "info", Hosts: []string{"a"}}
+	cfg.Server.Port = 8080
+	assert.EqualError(t, Validate(&cfg), "name is required")
+	assert.Error(t, Validate("config"))
+}
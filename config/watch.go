@@ -0,0 +1,232 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/config
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/knowntraveler/gogo/fs"
+)
+
+// WatchOptions configure Watch
+type WatchOptions struct {
+	// Before are sources loaded before the watched file (e.g. Defaults), which the
+	// file overrides
+	Before []Source
+
+	// After are sources loaded after the watched file (e.g. Env), which override it
+	After []Source
+
+	// Debounce coalesces the changes to the file until no further changes are seen
+	// for the duration (default 100ms), e.g. while an editor saves it
+	Debounce time.Duration
+
+	// Errors receives the errors of reloads that failed, which keep the current
+	// configuration, and of the underlying watcher (optional)
+	Errors chan<- error
+}
+
+// Watcher reloads configuration when its file changes until closed
+type Watcher struct {
+	watcher *fs.Watcher
+	events  chan fs.Event
+	path    string
+	initial reflect.Value
+	sources []Source
+	options WatchOptions
+	current atomic.Value
+	done    chan struct{}
+	once    sync.Once
+	wait    sync.WaitGroup
+
+	mutex       sync.Mutex
+	subscribers []func(cfg interface{})
+}
+
+// Watch simply loads configuration into cfg (a pointer to a struct) from a file like
+// Load, then reloads and validates it whenever the file changes, for long-running
+// daemons. Each reload loads a new struct of the same type, which replaces the current
+// configuration and is passed to fn (and other subscribers) only when it loads and
+// validates without errors, so a bad edit never replaces a working configuration.
+// Each reload starts from a copy of the values cfg held before Watch, so like Load the
+// fields not set by any source keep the values set by the caller.
+// cfg itself is not changed after Watch returns, read the current configuration from
+// the callbacks or Current(). Call Close() on the returned Watcher to stop watching.
+func Watch(path string, cfg interface{}, fn func(cfg interface{}), options ...WatchOptions) (*Watcher, error) {
+
+	// Validate Path Parameter
+	if path == "" {
+		return nil, fmt.Errorf("The 'path' parameter was empty. A path is required to watch Configuration")
+	}
+
+	// Apply Watch Options
+	watchOptions := WatchOptions{}
+	if len(options) > 0 {
+		watchOptions = options[0]
+	}
+	if watchOptions.Debounce <= 0 {
+		watchOptions.Debounce = 100 * time.Millisecond
+	}
+
+	// Load Configuration
+	path = filepath.Clean(path)
+	sources := append(append(append([]Source{}, watchOptions.Before...), File(path)), watchOptions.After...)
+	initial := cloneValue(reflect.ValueOf(cfg).Elem())
+	err := Load(cfg, sources...)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watcher{
+		events:  make(chan fs.Event, 16),
+		path:    path,
+		initial: initial,
+		sources: sources,
+		options: watchOptions,
+		done:    make(chan struct{}),
+	}
+	w.current.Store(cfg)
+	if fn != nil {
+		w.subscribers = append(w.subscribers, fn)
+	}
+
+	// Watch Directory (editors replace files when saving them)
+	w.watcher, err = fs.Watch([]string{filepath.Dir(path)}, w.events, fs.WatchOptions{Debounce: watchOptions.Debounce, Errors: watchOptions.Errors})
+	if err != nil {
+		return nil, err
+	}
+
+	w.wait.Add(1)
+	go w.run()
+
+	return w, nil
+}
+
+// Current returns the current configuration (a pointer to a struct of the type passed
+// to Watch), which is never changed once returned
+func (w *Watcher) Current() interface{} {
+	return w.current.Load()
+}
+
+// Subscribe adds a function called with each reloaded configuration, subscribers are
+// called in the order they subscribed and never concurrently
+func (w *Watcher) Subscribe(fn func(cfg interface{})) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.subscribers = append(w.subscribers, fn)
+}
+
+// Reload reloads the configuration now (e.g. on SIGHUP), replacing the current
+// configuration and notifying the subscribers unless it fails
+func (w *Watcher) Reload() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	// Load New Configuration (from a copy of the values set before Watch)
+	value := reflect.New(w.initial.Type())
+	value.Elem().Set(cloneValue(w.initial))
+	next := value.Interface()
+	err := Load(next, w.sources...)
+	if err != nil {
+		return fmt.Errorf("Unable to reload configuration from '%v': %w", w.path, err)
+	}
+
+	// Replace Current Configuration and Notify Subscribers
+	w.current.Store(next)
+	for _, fn := range w.subscribers {
+		fn(next)
+	}
+	return nil
+}
+
+// Close stops watching the configuration file, changes to the file are not reloaded
+// once it returns
+func (w *Watcher) Close() error {
+	var err error
+	w.once.Do(func() {
+		close(w.done)
+		err = w.watcher.Close()
+		w.wait.Wait()
+	})
+	return err
+}
+
+// run reloads the configuration on changes to the file until closed
+func (w *Watcher) run() {
+	defer w.wait.Done()
+	for {
+		select {
+		case event := <-w.events:
+			if event.Path != w.path || event.Op == fs.Chmod {
+				continue
+			}
+			err := w.Reload()
+			if err != nil && w.options.Errors != nil {
+				select {
+				case w.options.Errors <- err:
+				case <-w.done:
+				}
+			}
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// cloneValue returns a deep copy of a value, so loading configuration into the copy
+// never changes the maps, lists and pointers of the original
+func cloneValue(v reflect.Value) reflect.Value {
+	clone := reflect.New(v.Type()).Elem()
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			clone.Set(reflect.New(v.Type().Elem()))
+			clone.Elem().Set(cloneValue(v.Elem()))
+		}
+	case reflect.Interface:
+		if !v.IsNil() {
+			clone.Set(cloneValue(v.Elem()))
+		}
+	case reflect.Map:
+		if !v.IsNil() {
+			clone.Set(reflect.MakeMapWithSize(v.Type(), v.Len()))
+			iter := v.MapRange()
+			for iter.Next() {
+				clone.SetMapIndex(iter.Key(), cloneValue(iter.Value()))
+			}
+		}
+	case reflect.Slice:
+		if !v.IsNil() {
+			clone.Set(reflect.MakeSlice(v.Type(), v.Len(), v.Len()))
+			for i := 0; i < v.Len(); i++ {
+				clone.Index(i).Set(cloneValue(v.Index(i)))
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			clone.Index(i).Set(cloneValue(v.Index(i)))
+		}
+	case reflect.Struct:
+		// Unexported Fields are copied as they are
+		clone.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if clone.Field(i).CanSet() {
+				clone.Field(i).Set(cloneValue(v.Field(i)))
+			}
+		}
+	default:
+		clone.Set(v)
+	}
+	return clone
+}
@@ -0,0 +1,64 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/config
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWatch is a unit test for config.Watch()
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("name: app\n"), 0644))
+
+	cfg := validConfig{Workers: []validWorker{{Queue: "jobs"}}}
+	reloads := make(chan *validConfig, 4)
+	errs := make(chan error, 4)
+	watcher, err := Watch(path, &cfg, func(cfg interface{}) { reloads <- cfg.(*validConfig) }, WatchOptions{Debounce: 50 * time.Millisecond, Errors: errs})
+	assert.NoError(t, err)
+	defer watcher.Close()
+	assert.Equal(t, "app", cfg.Name)
+	assert.Equal(t, &cfg, watcher.Current())
+
+	// Assert Reload on Change
+	assert.NoError(t, os.WriteFile(path, []byte("name: reloaded\nserver:\n  port: 9090\n"), 0644))
+	select {
+	case reloaded := <-reloads:
+		assert.Equal(t, "reloaded", reloaded.Name)
+		assert.Equal(t, 9090, reloaded.Server.Port)
+		assert.Equal(t, reloaded, watcher.Current())
+		// Assert Values set before Watch are Kept
+		assert.Equal(t, []validWorker{{Queue: "jobs"}}, reloaded.Workers)
+		reloaded.Workers[0].Queue = "other"
+		assert.Equal(t, []validWorker{{Queue: "jobs"}}, cfg.Workers)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for reload")
+	}
+	assert.Equal(t, "app", cfg.Name)
+
+	// Assert Invalid Changes keep the Current Configuration
+	assert.NoError(t, os.WriteFile(path, []byte("name: reloaded\nserver:\n  port: 70000\n"), 0644))
+	select {
+	case err := <-errs:
+		assert.ErrorContains(t, err, "server.port must be 1-65535")
+		assert.Equal(t, 9090, watcher.Current().(*validConfig).Server.Port)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for reload error")
+	}
+
+	assert.NoError(t, watcher.Close())
+	_, err = Watch(filepath.Join(dir, "missing.yaml"), &cfg, nil)
+	assert.Error(t, err)
+}
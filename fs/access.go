@@ -0,0 +1,26 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+// IsReadable simply checks if the current user can read a file or list a directory
+func IsReadable(path string) bool {
+	return canRead(path)
+}
+
+// IsWritable simply checks if the current user can write to a file or create
+// files in a directory, taking ownership, ACLs and read-only mounts into account
+func IsWritable(path string) bool {
+	return canWrite(path)
+}
+
+// IsExecutable simply checks if the current user can execute a file
+// (on Windows, if the file extension is listed in %PATHEXT%)
+func IsExecutable(path string) bool {
+	return canExecute(path)
+}
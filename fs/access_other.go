@@ -0,0 +1,37 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !windows
+// +build !windows
+
+package fs
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// canRead checks read access with access(2)
+func canRead(path string) bool {
+	return unix.Access(path, unix.R_OK) == nil
+}
+
+// canWrite checks write access with access(2)
+func canWrite(path string) bool {
+	return unix.Access(path, unix.W_OK) == nil
+}
+
+// canExecute checks execute access with access(2), excluding directories
+func canExecute(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	return unix.Access(path, unix.X_OK) == nil
+}
@@ -0,0 +1,42 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIsWritable is a unit test for fs.IsReadable(), fs.IsWritable() and fs.IsExecutable()
+func TestIsWritable(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "run.cmd")
+	if runtime.GOOS != "windows" {
+		script = filepath.Join(dir, "run.sh")
+	}
+	text := filepath.Join(dir, "notes.txt")
+	assert.NoError(t, OverwriteFile(script, 0755, []byte("echo")))
+	assert.NoError(t, OverwriteFile(text, 0644, []byte("notes")))
+
+	// Assert Unit Test
+	assert.True(t, IsReadable(text))
+	assert.True(t, IsWritable(text))
+	assert.True(t, IsWritable(dir))
+	assert.True(t, IsExecutable(script))
+	assert.False(t, IsExecutable(text))
+	assert.False(t, IsExecutable(dir))
+
+	missing := filepath.Join(dir, "missing")
+	assert.False(t, IsReadable(missing))
+	assert.False(t, IsWritable(missing))
+	assert.False(t, IsExecutable(missing))
+}
@@ -0,0 +1,84 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build windows
+// +build windows
+
+package fs
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// canRead checks read access by opening the file or directory
+func canRead(path string) bool {
+	file, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	if info.IsDir() {
+		_, err = file.Readdirnames(1)
+		return err == nil || err == io.EOF
+	}
+	return true
+}
+
+// canWrite checks write access by opening a file for writing (without truncating it),
+// or by creating and removing a temporary file in a directory
+func canWrite(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	if info.IsDir() {
+		file, err := os.CreateTemp(path, ".gogo-access-*")
+		if err != nil {
+			return false
+		}
+		file.Close()
+		os.Remove(file.Name())
+		return true
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return false
+	}
+	file.Close()
+	return true
+}
+
+// canExecute checks if a readable file has an extension listed in %PATHEXT%
+func canExecute(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() || !canRead(path) {
+		return false
+	}
+
+	extensions := os.Getenv("PATHEXT")
+	if extensions == "" {
+		extensions = ".com;.exe;.bat;.cmd"
+	}
+	extension := strings.ToLower(filepath.Ext(path))
+	for _, executable := range strings.Split(strings.ToLower(extensions), ";") {
+		if extension != "" && extension == executable {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// ModifiedSince simply checks if a file was modified after t
+// Returns TRUE if the modification time is after t
+// Returns FALSE if the modification time is at or before t
+func ModifiedSince(path string, t time.Time) (bool, error) {
+
+	// Check IF Path Exists
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, fmt.Errorf("Path '%v' doesn't exist", path)
+	}
+
+	return info.ModTime().After(t), nil
+}
+
+// Age simply returns how long ago a file was last modified
+func Age(path string) (time.Duration, error) {
+
+	// Check IF Path Exists
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, fmt.Errorf("Path '%v' doesn't exist", path)
+	}
+
+	return time.Since(info.ModTime()), nil
+}
+
+// NewestIn returns the most recently modified regular file in a directory tree
+// and its modification time, returning an error if the tree has no files
+func NewestIn(dir string) (string, time.Time, error) {
+
+	// Check IF Directory Exists
+	info, err := os.Stat(dir)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("Directory '%v' doesn't exist", dir)
+	}
+	if !info.IsDir() {
+		return "", time.Time{}, fmt.Errorf("Directory '%v' is not a directory", dir)
+	}
+
+	// Find Newest File
+	var newest string
+	var modified time.Time
+	err = Walk(dir, WalkOptions{}, func(path string, info os.FileInfo) error {
+		if info.Mode().IsRegular() && (newest == "" || info.ModTime().After(modified)) {
+			newest, modified = path, info.ModTime()
+		}
+		return nil
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if newest == "" {
+		return "", time.Time{}, fmt.Errorf("Directory '%v' has no files", dir)
+	}
+
+	return newest, modified, nil
+}
@@ -0,0 +1,43 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewestIn is a unit test for fs.ModifiedSince(), fs.Age() and fs.NewestIn()
+func TestNewestIn(t *testing.T) {
+	dir := t.TempDir()
+	old := filepath.Join(dir, "old.txt")
+	newest := filepath.Join(dir, "sub", "new.txt")
+	assert.NoError(t, TouchAll(old))
+	assert.NoError(t, TouchAll(newest))
+	hourAgo := time.Now().Add(-time.Hour)
+	assert.NoError(t, os.Chtimes(old, hourAgo, hourAgo))
+
+	path, modified, err := NewestIn(dir)
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, newest, path)
+	assert.WithinDuration(t, time.Now(), modified, time.Minute)
+
+	since, err := ModifiedSince(old, time.Now().Add(-2*time.Hour))
+	assert.NoError(t, err)
+	assert.True(t, since)
+
+	age, err := Age(old)
+	assert.NoError(t, err)
+	assert.InDelta(t, time.Hour.Seconds(), age.Seconds(), 60)
+}
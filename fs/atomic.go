@@ -0,0 +1,81 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// WriteFileAtomic writes data to a temporary file in the same directory as path,
+// flushes it to disk and renames it into place, so a partially written file can
+// never be observed at path (even after a crash). An existing file is replaced.
+func WriteFileAtomic(path string, mode os.FileMode, data []byte) error {
+
+	// Create Temporary File in the same Directory (rename is only atomic within a filesystem)
+	dir := filepath.Dir(path)
+	file, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmp := file.Name()
+
+	// Remove Temporary File on Failure
+	success := false
+	defer func() {
+		if !success {
+			file.Close()
+			os.Remove(tmp)
+		}
+	}()
+
+	// Write File
+	_, err = file.Write(data)
+	if err != nil {
+		return err
+	}
+
+	// Save File Changes
+	err = file.Sync()
+	if err != nil {
+		return err
+	}
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	// Set File Permissions
+	err = os.Chmod(tmp, mode)
+	if err != nil {
+		return err
+	}
+
+	// Rename Temporary File into place
+	err = os.Rename(tmp, path)
+	if err != nil {
+		return err
+	}
+	success = true
+
+	// Save Directory Changes (best effort, not supported on all platforms)
+	syncDirectory(dir)
+
+	return nil
+}
+
+// syncDirectory flushes a directory entry (e.g. after a rename) to disk
+func syncDirectory(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
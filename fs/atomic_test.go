@@ -0,0 +1,58 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWriteFileAtomic is a unit test for fs.WriteFileAtomic()
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state.json")
+
+	for _, data := range []string{`{"version": 1}`, `{}`} {
+		// Assert Unit Test
+		assert.NoError(t, WriteFileAtomic(path, 0600, []byte(data)))
+		actual, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, data, string(actual))
+	}
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+// TestWriteFileAtomicErrors is a unit test for fs.WriteFileAtomic() leaving no temporary file on failure
+func TestWriteFileAtomicErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	// Assert Unit Test
+	path := filepath.Join(dir, "state")
+	assert.NoError(t, os.MkdirAll(filepath.Join(path, "sub"), 0755))
+	assert.Error(t, WriteFileAtomic(path, 0644, []byte("replaces a directory")))
+	assert.DirExists(t, filepath.Join(path, "sub"))
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// Assert a Missing Directory is not Created
+	assert.Error(t, WriteFileAtomic(filepath.Join(dir, "missing", "state.json"), 0644, []byte("{}")))
+	assert.NoDirExists(t, filepath.Join(dir, "missing"))
+}
@@ -0,0 +1,103 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// backupOptions configure BackupFile
+type backupOptions struct {
+	keep int
+}
+
+// BackupOption configures BackupFile
+type BackupOption func(*backupOptions)
+
+// BackupKeep keeps up to keep numbered backups (<path>.bak.1 being the newest)
+// instead of a single <path>.bak backup (default 1)
+func BackupKeep(keep int) BackupOption {
+	return func(o *backupOptions) {
+		o.keep = keep
+	}
+}
+
+// BackupFile simply copies a file to <path>.bak before a destructive modification
+// and returns the backup path. With BackupKeep(n) numbered backups are rotated
+// and backups older than the newest n are deleted.
+func BackupFile(path string, opts ...BackupOption) (string, error) {
+
+	// Apply Backup Options
+	options := backupOptions{keep: 1}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// Check IF File Exists
+	_, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("File '%v' doesn't exist", path)
+	}
+
+	// Single Backup
+	if options.keep <= 1 {
+		backup := path + ".bak"
+		return backup, CopyFile(path, backup, CopyOverwrite(true))
+	}
+
+	// Rotate Numbered Backups
+	os.Remove(numberedBackup(path, options.keep))
+	for n := options.keep - 1; n >= 1; n-- {
+		err = os.Rename(numberedBackup(path, n), numberedBackup(path, n+1))
+		if err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+
+	backup := numberedBackup(path, 1)
+	return backup, CopyFile(path, backup, CopyOverwrite(true))
+}
+
+// RestoreBackup replaces a file with its newest backup (<path>.bak or <path>.bak.1),
+// keeping the backup in place
+func RestoreBackup(path string) error {
+
+	// Find Newest Backup
+	backup := path + ".bak"
+	if _, err := os.Stat(backup); err != nil {
+		backup = numberedBackup(path, 1)
+		if _, err := os.Stat(backup); err != nil {
+			return fmt.Errorf("Backup of File '%v' doesn't exist", path)
+		}
+	}
+
+	// Copy Backup to Temporary File
+	temp := filepath.Join(filepath.Dir(path), fmt.Sprintf(".%v.restore-%d", filepath.Base(path), os.Getpid()))
+	err := CopyFile(backup, temp, CopyOverwrite(true))
+	if err != nil {
+		os.Remove(temp)
+		return err
+	}
+
+	// Replace File
+	err = os.Rename(temp, path)
+	if err != nil {
+		os.Remove(temp)
+		return err
+	}
+
+	return nil
+}
+
+// numberedBackup returns the path of the nth numbered backup of a file
+func numberedBackup(path string, n int) string {
+	return fmt.Sprintf("%v.bak.%d", path, n)
+}
@@ -0,0 +1,39 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBackupFile is a unit test for fs.BackupFile() and fs.RestoreBackup()
+func TestBackupFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	for _, version := range []string{"v1", "v2", "v3"} {
+		assert.NoError(t, OverwriteFile(path, 0644, []byte(version)))
+		backup, err := BackupFile(path, BackupKeep(2))
+		// Assert Unit Test
+		assert.NoError(t, err)
+		assert.Equal(t, path+".bak.1", backup)
+	}
+	assert.NoError(t, OverwriteFile(path, 0644, []byte("broken")))
+
+	data, _ := os.ReadFile(path + ".bak.2")
+	assert.Equal(t, "v2", string(data))
+	assert.NoFileExists(t, path+".bak.3")
+
+	assert.NoError(t, RestoreBackup(path))
+	data, _ = os.ReadFile(path)
+	assert.Equal(t, "v3", string(data))
+}
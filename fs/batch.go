@@ -0,0 +1,233 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// batchOptions configure a Batch
+type batchOptions struct {
+	workers  int
+	rollback bool
+}
+
+// BatchOption configures a Batch
+type BatchOption func(*batchOptions)
+
+// BatchWorkers sets the number of operations run concurrently (default 4)
+func BatchWorkers(workers int) BatchOption {
+	return func(o *batchOptions) {
+		o.workers = workers
+	}
+}
+
+// BatchRollback undoes the completed operations when any operation fails (default false):
+// copied files and created directories are removed and deleted paths are restored
+func BatchRollback(rollback bool) BatchOption {
+	return func(o *batchOptions) {
+		o.rollback = rollback
+	}
+}
+
+// OperationBatch is a queue of file operations created by Batch
+type OperationBatch struct {
+	options    batchOptions
+	mkdirs     []batchOperation
+	operations []batchOperation
+}
+
+// batchOperation is a queued operation, run returns functions to undo
+// the operation and to commit it once the whole batch succeeded
+type batchOperation struct {
+	name string
+	run  func(rollback bool) (undo func() error, commit func(), err error)
+}
+
+// Batch returns an empty OperationBatch, queue operations with Copy, Delete and
+// Mkdir and execute them with Run
+func Batch(opts ...BatchOption) *OperationBatch {
+
+	// Apply Batch Options
+	options := batchOptions{workers: 4}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.workers < 1 {
+		options.workers = 1
+	}
+
+	return &OperationBatch{options: options}
+}
+
+// Copy queues copying a file with CopyFile (the destination must not exist)
+func (batch *OperationBatch) Copy(src string, dst string) *OperationBatch {
+	batch.operations = append(batch.operations, batchOperation{
+		name: fmt.Sprintf("copy '%v' to '%v'", src, dst),
+		run: func(rollback bool) (func() error, func(), error) {
+			err := CopyFile(src, dst)
+			undo := func() error {
+				return os.Remove(dst)
+			}
+			return undo, nil, err
+		},
+	})
+	return batch
+}
+
+// Delete queues deleting a file or directory tree, which is moved aside until
+// the batch succeeds when BatchRollback is enabled
+func (batch *OperationBatch) Delete(path string) *OperationBatch {
+	batch.operations = append(batch.operations, batchOperation{
+		name: fmt.Sprintf("delete '%v'", path),
+		run: func(rollback bool) (func() error, func(), error) {
+			if _, err := os.Lstat(path); err != nil {
+				return nil, nil, fmt.Errorf("Path '%v' doesn't exist", path)
+			}
+			if !rollback {
+				return nil, nil, os.RemoveAll(path)
+			}
+
+			// Move Path Aside
+			aside := filepath.Join(filepath.Dir(path), fmt.Sprintf(".%v.batch-%d", filepath.Base(path), os.Getpid()))
+			err := os.Rename(path, aside)
+			undo := func() error {
+				return os.Rename(aside, path)
+			}
+			commit := func() {
+				os.RemoveAll(aside)
+			}
+			return undo, commit, err
+		},
+	})
+	return batch
+}
+
+// Mkdir queues creating a directory and any missing parents, Mkdir operations
+// run before any other operation so queued copies can target new directories
+func (batch *OperationBatch) Mkdir(path string, mode os.FileMode) *OperationBatch {
+	batch.mkdirs = append(batch.mkdirs, batchOperation{
+		name: fmt.Sprintf("mkdir '%v'", path),
+		run: func(rollback bool) (func() error, func(), error) {
+			// Find First Missing Directory
+			created := ""
+			for dir := filepath.Clean(path); ; dir = filepath.Dir(dir) {
+				if _, err := os.Stat(dir); err == nil || dir == filepath.Dir(dir) {
+					break
+				}
+				created = dir
+			}
+			err := os.MkdirAll(path, mode)
+			undo := func() error {
+				if created == "" {
+					return nil
+				}
+				return os.RemoveAll(created)
+			}
+			return undo, nil, err
+		},
+	})
+	return batch
+}
+
+// Len returns the number of queued operations
+func (batch *OperationBatch) Len() int {
+	return len(batch.mkdirs) + len(batch.operations)
+}
+
+// Run executes the queued operations (Mkdir operations first, in order, then the
+// remaining operations concurrently) and returns every failure joined into one
+// error. With BatchRollback enabled, Run stops at the first failure and undoes the
+// completed operations. The queue is emptied so the batch can be reused.
+func (batch *OperationBatch) Run() error {
+	mkdirs, operations := batch.mkdirs, batch.operations
+	batch.mkdirs, batch.operations = nil, nil
+	rollback := batch.options.rollback
+
+	var mutex sync.Mutex
+	var errs []error
+	var undos []func() error
+	var commits []func()
+
+	// record stores the result of an operation
+	record := func(op batchOperation, undo func() error, commit func(), err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Failed to %v: %w", op.name, err))
+			return
+		}
+		if undo != nil {
+			undos = append(undos, undo)
+		}
+		if commit != nil {
+			commits = append(commits, commit)
+		}
+	}
+
+	// failed reports whether Run should stop scheduling operations
+	failed := func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return rollback && len(errs) > 0
+	}
+
+	// Create Directories
+	for _, op := range mkdirs {
+		if failed() {
+			break
+		}
+		undo, commit, err := op.run(rollback)
+		record(op, undo, commit, err)
+	}
+
+	// Run Operations Concurrently
+	queue := make(chan batchOperation)
+	var wait sync.WaitGroup
+	for i := 0; i < batch.options.workers; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			for op := range queue {
+				if failed() {
+					continue
+				}
+				undo, commit, err := op.run(rollback)
+				record(op, undo, commit, err)
+			}
+		}()
+	}
+	for _, op := range operations {
+		queue <- op
+	}
+	close(queue)
+	wait.Wait()
+
+	if len(errs) == 0 {
+		for _, commit := range commits {
+			commit()
+		}
+		return nil
+	}
+
+	// Roll Back Completed Operations (newest first)
+	if rollback {
+		for i := len(undos) - 1; i >= 0; i-- {
+			if err := undos[i](); err != nil {
+				errs = append(errs, fmt.Errorf("Failed to roll back: %w", err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,58 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBatch is a unit test for fs.Batch()
+func TestBatch(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.txt")
+	old := filepath.Join(dir, "old.txt")
+	assert.NoError(t, TouchAll(src))
+	assert.NoError(t, TouchAll(old))
+
+	batch := Batch(BatchWorkers(2)).
+		Mkdir(filepath.Join(dir, "out", "sub"), 0755).
+		Copy(src, filepath.Join(dir, "out", "sub", "a.txt")).
+		Copy(src, filepath.Join(dir, "out", "sub", "b.txt")).
+		Delete(old)
+
+	// Assert Unit Test
+	assert.Equal(t, 4, batch.Len())
+	assert.NoError(t, batch.Run())
+	assert.FileExists(t, filepath.Join(dir, "out", "sub", "a.txt"))
+	assert.FileExists(t, filepath.Join(dir, "out", "sub", "b.txt"))
+	assert.NoFileExists(t, old)
+}
+
+// TestBatchRollback is a unit test for fs.Batch() with fs.BatchRollback()
+func TestBatchRollback(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.txt")
+	keep := filepath.Join(dir, "keep.txt")
+	assert.NoError(t, TouchAll(src))
+	assert.NoError(t, TouchAll(keep))
+
+	err := Batch(BatchWorkers(1), BatchRollback(true)).
+		Mkdir(filepath.Join(dir, "out"), 0755).
+		Delete(keep).
+		Copy(filepath.Join(dir, "missing.txt"), filepath.Join(dir, "out", "missing.txt")).
+		Run()
+
+	// Assert Unit Test
+	assert.Error(t, err)
+	assert.FileExists(t, keep)
+	assert.NoDirExists(t, filepath.Join(dir, "out"))
+}
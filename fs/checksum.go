@@ -0,0 +1,133 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+)
+
+// Algorithm identifies a checksum algorithm
+type Algorithm string
+
+// Checksum Algorithms
+const (
+	MD5    Algorithm = "md5"
+	SHA1   Algorithm = "sha1"
+	SHA256 Algorithm = "sha256"
+	SHA512 Algorithm = "sha512"
+)
+
+// newHash returns a new hash.Hash for an Algorithm
+func newHash(algorithm Algorithm) (hash.Hash, error) {
+	switch Algorithm(strings.ToLower(string(algorithm))) {
+	case MD5:
+		return md5.New(), nil
+	case SHA1:
+		return sha1.New(), nil
+	case SHA256:
+		return sha256.New(), nil
+	case SHA512:
+		return sha512.New(), nil
+	}
+	return nil, fmt.Errorf("Checksum algorithm '%v' is not supported", algorithm)
+}
+
+// Checksum simply returns the hex encoded checksum of a file, streaming the
+// file contents through the hash rather than reading the whole file into memory
+func Checksum(path string, algorithm Algorithm) (string, error) {
+
+	// Check Checksum Algorithm
+	_, err := newHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	// Check IF File Exists
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("File '%v' doesn't exist", path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("Unable to open file '%v': %w", path, err)
+	}
+	defer file.Close()
+
+	return ChecksumReader(file, algorithm)
+}
+
+// ChecksumReader simply returns the hex encoded checksum of the contents read from r
+// (e.g. an archive entry or a download in progress)
+func ChecksumReader(r io.Reader, algorithm Algorithm) (string, error) {
+
+	h, err := newHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	// Hash Contents
+	_, err = io.Copy(h, r)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// VerifyChecksum checks a file against an expected hex encoded checksum, either
+// prefixed with the algorithm (e.g. "sha256:9f86d0...") or detected from its length
+func VerifyChecksum(path string, expected string) error {
+
+	// Detect Checksum Algorithm
+	algorithm, sum := DetectAlgorithm(strings.TrimSpace(expected))
+	if algorithm == "" {
+		return fmt.Errorf("Checksum '%v' has an unknown algorithm", expected)
+	}
+
+	actual, err := Checksum(path, algorithm)
+	if err != nil {
+		return err
+	}
+
+	// Compare Checksums
+	if !strings.EqualFold(actual, sum) {
+		return fmt.Errorf("File '%v' %v checksum mismatch (expected %v, got %v)", path, algorithm, sum, actual)
+	}
+
+	return nil
+}
+
+// DetectAlgorithm returns the Algorithm and hex digest of an expected checksum, either
+// prefixed with the algorithm (e.g. "sha256:9f86d0...") or detected from its length
+// ("" when unknown)
+func DetectAlgorithm(expected string) (Algorithm, string) {
+	if i := strings.Index(expected, ":"); i > 0 {
+		return Algorithm(strings.ToLower(expected[:i])), expected[i+1:]
+	}
+	switch len(expected) {
+	case 32:
+		return MD5, expected
+	case 40:
+		return SHA1, expected
+	case 64:
+		return SHA256, expected
+	case 128:
+		return SHA512, expected
+	}
+	return "", expected
+}
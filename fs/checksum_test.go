@@ -0,0 +1,64 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"errors"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestChecksum is a unit test for fs.Checksum(), fs.ChecksumReader(), fs.VerifyChecksum()
+// and fs.DetectAlgorithm()
+func TestChecksum(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.txt")
+	assert.NoError(t, OverwriteFile(path, 0644, []byte("test")))
+
+	sum, err := Checksum(path, SHA256)
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", sum)
+
+	sum, err = Checksum(path, MD5)
+	assert.NoError(t, err)
+	assert.Equal(t, "098f6bcd4621d373cade4e832627b4f6", sum)
+
+	assert.NoError(t, VerifyChecksum(path, "098f6bcd4621d373cade4e832627b4f6"))
+	assert.NoError(t, VerifyChecksum(path, "sha256:9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08"))
+	assert.Error(t, VerifyChecksum(path, "00000000000000000000000000000000"))
+	assert.Error(t, VerifyChecksum(path, "crc:1234"))
+
+	sum, err = ChecksumReader(strings.NewReader("test"), SHA1)
+	assert.NoError(t, err)
+	assert.Equal(t, "a94a8fe5ccb19ba61c4c0873d391e987982fbbd3", sum)
+	_, err = ChecksumReader(strings.NewReader("test"), "crc")
+	assert.Error(t, err)
+
+	algorithm, sum := DetectAlgorithm("SHA512:abc")
+	assert.Equal(t, SHA512, algorithm)
+	assert.Equal(t, "abc", sum)
+	algorithm, _ = DetectAlgorithm("098f6bcd4621d373cade4e832627b4f6")
+	assert.Equal(t, MD5, algorithm)
+	algorithm, _ = DetectAlgorithm("abc")
+	assert.Equal(t, Algorithm(""), algorithm)
+
+	// Assert only a Missing File doesn't exist
+	_, err = Checksum(filepath.Join(filepath.Dir(path), "missing.txt"), SHA256)
+	assert.EqualError(t, err, "File '"+filepath.Join(filepath.Dir(path), "missing.txt")+"' doesn't exist")
+	if runtime.GOOS != "windows" {
+		_, err = Checksum(filepath.Join(path, "child"), SHA256)
+		assert.True(t, errors.Is(err, syscall.ENOTDIR), err)
+		assert.NotContains(t, err.Error(), "doesn't exist")
+	}
+}
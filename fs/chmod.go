@@ -0,0 +1,80 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+)
+
+// ChmodRecursive sets the permissions of every file (fileMode) and directory (dirMode)
+// in a tree, skipping symlinks. Optional WalkOptions limit the paths changed
+// (e.g. Include: []string{"*.sh"}, Exclude: []string{".git/"}).
+func ChmodRecursive(path string, fileMode os.FileMode, dirMode os.FileMode, options ...WalkOptions) error {
+
+	// Check IF Path Exists
+	_, err := os.Lstat(path)
+	if err != nil {
+		return fmt.Errorf("Path '%v' doesn't exist", path)
+	}
+
+	walkOptions := WalkOptions{}
+	if len(options) > 0 {
+		walkOptions = options[0]
+		walkOptions.Concurrency = 0
+	}
+
+	// Set File Permissions (directories are changed after the walk so
+	// that restrictive modes don't prevent reading their contents)
+	var dirs []string
+	err = Walk(path, walkOptions, func(path string, info os.FileInfo) error {
+		switch {
+		case info.IsDir():
+			dirs = append(dirs, path)
+		case info.Mode().IsRegular():
+			return os.Chmod(path, fileMode)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Set Directory Permissions (deepest first)
+	for i := len(dirs) - 1; i >= 0; i-- {
+		err = os.Chmod(dirs[i], dirMode)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ChownRecursive sets the owner and group of every file, directory and symlink in
+// a tree (-1 leaves the uid or gid unchanged). Optional WalkOptions limit the paths
+// changed. ChownRecursive is not supported on Windows.
+func ChownRecursive(path string, uid int, gid int, options ...WalkOptions) error {
+
+	// Check IF Path Exists
+	_, err := os.Lstat(path)
+	if err != nil {
+		return fmt.Errorf("Path '%v' doesn't exist", path)
+	}
+
+	walkOptions := WalkOptions{}
+	if len(options) > 0 {
+		walkOptions = options[0]
+	}
+
+	// Set File Ownership
+	return Walk(path, walkOptions, func(path string, info os.FileInfo) error {
+		return os.Lchown(path, uid, gid)
+	})
+}
@@ -0,0 +1,39 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestChmodRecursive is a unit test for fs.ChmodRecursive()
+func TestChmodRecursive(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permissions are not supported on windows")
+	}
+	dir := t.TempDir()
+	assert.NoError(t, TouchAll(filepath.Join(dir, "bin", "run.sh")))
+	assert.NoError(t, TouchAll(filepath.Join(dir, "bin", "README.md")))
+
+	err := ChmodRecursive(dir, 0755, 0700, WalkOptions{Include: []string{"*.sh"}})
+	// Assert Unit Test
+	assert.NoError(t, err)
+
+	info, _ := os.Stat(filepath.Join(dir, "bin", "run.sh"))
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+	info, _ = os.Stat(filepath.Join(dir, "bin", "README.md"))
+	assert.NotEqual(t, os.FileMode(0755), info.Mode().Perm())
+	info, _ = os.Stat(filepath.Join(dir, "bin"))
+	assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+}
@@ -0,0 +1,45 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+)
+
+// Clone simply copies a regular file to a destination path that doesn't exist yet,
+// sharing the data blocks of the source file (copy-on-write) on filesystems that
+// support it: FICLONE on Linux (btrfs, XFS) and clonefile on macOS (APFS). On other
+// filesystems and platforms Clone falls back to CopyFile. The file mode and
+// modification time are preserved.
+func Clone(src string, dst string) error {
+
+	// Check IF Source File Exists
+	info, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("File '%v' doesn't exist", src)
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("File '%v' is not a regular file", src)
+	}
+
+	// Check IF Destination File Exists
+	_, err = os.Lstat(dst)
+	if err == nil {
+		return fmt.Errorf("File '%v' already exists", dst)
+	}
+
+	// Clone File (copy-on-write)
+	if cloneFile(src, dst, info) == nil {
+		return nil
+	}
+
+	// Copy File
+	return CopyFile(src, dst)
+}
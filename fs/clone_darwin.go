@@ -0,0 +1,23 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build darwin
+// +build darwin
+
+package fs
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// cloneFile clones a file with clonefile(2), which also preserves its metadata
+func cloneFile(src string, dst string, info os.FileInfo) error {
+	return unix.Clonefile(src, dst, unix.CLONE_NOFOLLOW)
+}
@@ -0,0 +1,22 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package fs
+
+import (
+	"errors"
+	"os"
+)
+
+// cloneFile is not supported on this platform, Clone falls back to CopyFile
+func cloneFile(src string, dst string, info os.FileInfo) error {
+	return errors.New("copy-on-write clone is not supported")
+}
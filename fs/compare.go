@@ -0,0 +1,176 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// FilesEqual simply checks if two files have the same contents, comparing
+// their sizes first and then streaming both files in chunks
+func FilesEqual(a string, b string) (bool, error) {
+
+	// Check IF Files Exist
+	infoA, err := os.Stat(a)
+	if err != nil {
+		return false, fmt.Errorf("File '%v' doesn't exist", a)
+	}
+	infoB, err := os.Stat(b)
+	if err != nil {
+		return false, fmt.Errorf("File '%v' doesn't exist", b)
+	}
+	if !infoA.Mode().IsRegular() {
+		return false, fmt.Errorf("File '%v' is not a regular file", a)
+	}
+	if !infoB.Mode().IsRegular() {
+		return false, fmt.Errorf("File '%v' is not a regular file", b)
+	}
+
+	// Compare File Sizes
+	if infoA.Size() != infoB.Size() {
+		return false, nil
+	}
+	if os.SameFile(infoA, infoB) {
+		return true, nil
+	}
+
+	// Open Files
+	fileA, err := os.Open(a)
+	if err != nil {
+		return false, err
+	}
+	defer fileA.Close()
+	fileB, err := os.Open(b)
+	if err != nil {
+		return false, err
+	}
+	defer fileB.Close()
+
+	// Compare File Contents
+	bufA := make([]byte, 32*1024)
+	bufB := make([]byte, 32*1024)
+	for {
+		n, errA := io.ReadFull(fileA, bufA)
+		m, errB := io.ReadFull(fileB, bufB)
+		if !bytes.Equal(bufA[:n], bufB[:m]) {
+			return false, nil
+		}
+		if errA == io.EOF || errA == io.ErrUnexpectedEOF {
+			return errB == io.EOF || errB == io.ErrUnexpectedEOF, nil
+		}
+		if errA != nil {
+			return false, errA
+		}
+		if errB != nil {
+			return false, errB
+		}
+	}
+}
+
+// DirsEqual recursively compares two directory trees and returns whether they are
+// equal, along with the sorted relative paths that differ (missing from either tree,
+// different file types, different file contents or different symlink targets)
+func DirsEqual(a string, b string) (bool, []string, error) {
+
+	// List Directory Trees
+	treeA, err := listTree(a)
+	if err != nil {
+		return false, nil, err
+	}
+	treeB, err := listTree(b)
+	if err != nil {
+		return false, nil, err
+	}
+
+	// Compare Paths in Both Trees
+	differences := []string{}
+	for rel, infoA := range treeA {
+		infoB, ok := treeB[rel]
+		if !ok {
+			differences = append(differences, rel)
+			continue
+		}
+
+		equal, err := entriesEqual(filepath.Join(a, rel), infoA, filepath.Join(b, rel), infoB)
+		if err != nil {
+			return false, nil, err
+		}
+		if !equal {
+			differences = append(differences, rel)
+		}
+	}
+	for rel := range treeB {
+		if _, ok := treeA[rel]; !ok {
+			differences = append(differences, rel)
+		}
+	}
+
+	sort.Strings(differences)
+	return len(differences) == 0, differences, nil
+}
+
+// listTree returns the FileInfo of each path below a directory, keyed by slash-separated relative path
+func listTree(root string) (map[string]os.FileInfo, error) {
+
+	// Check IF Directory Exists
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("Directory '%v' doesn't exist", root)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("Directory '%v' is not a directory", root)
+	}
+
+	tree := map[string]os.FileInfo{}
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == root {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		tree[filepath.ToSlash(rel)] = info
+		return nil
+	})
+
+	return tree, err
+}
+
+// entriesEqual compares two entries of a directory tree with the same relative path
+func entriesEqual(a string, infoA os.FileInfo, b string, infoB os.FileInfo) (bool, error) {
+	if infoA.Mode().Type() != infoB.Mode().Type() {
+		return false, nil
+	}
+
+	switch {
+	case infoA.Mode().IsRegular():
+		return FilesEqual(a, b)
+	case infoA.Mode()&os.ModeSymlink != 0:
+		targetA, err := os.Readlink(a)
+		if err != nil {
+			return false, err
+		}
+		targetB, err := os.Readlink(b)
+		if err != nil {
+			return false, err
+		}
+		return targetA == targetB, nil
+	}
+
+	return true, nil
+}
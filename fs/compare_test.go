@@ -0,0 +1,48 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDirsEqual is a unit test for fs.FilesEqual() and fs.DirsEqual()
+func TestDirsEqual(t *testing.T) {
+	a, b := t.TempDir(), t.TempDir()
+	for _, dir := range []string{a, b} {
+		assert.NoError(t, TouchAll(filepath.Join(dir, "same", "empty.txt")))
+		assert.NoError(t, OverwriteFile(filepath.Join(dir, "same.txt"), 0644, []byte("same")))
+	}
+	assert.NoError(t, OverwriteFile(filepath.Join(a, "changed.txt"), 0644, []byte("abcd")))
+	assert.NoError(t, OverwriteFile(filepath.Join(b, "changed.txt"), 0644, []byte("abce")))
+	assert.NoError(t, TouchAll(filepath.Join(a, "only-a.txt")))
+	assert.NoError(t, TouchAll(filepath.Join(b, "sub", "only-b.txt")))
+
+	equal, err := FilesEqual(filepath.Join(a, "same.txt"), filepath.Join(b, "same.txt"))
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.True(t, equal)
+
+	equal, err = FilesEqual(filepath.Join(a, "changed.txt"), filepath.Join(b, "changed.txt"))
+	assert.NoError(t, err)
+	assert.False(t, equal)
+
+	equal, differences, err := DirsEqual(a, b)
+	assert.NoError(t, err)
+	assert.False(t, equal)
+	assert.Equal(t, []string{"changed.txt", "only-a.txt", "sub", "sub/only-b.txt"}, differences)
+
+	equal, differences, err = DirsEqual(filepath.Join(a, "same"), filepath.Join(b, "same"))
+	assert.NoError(t, err)
+	assert.True(t, equal)
+	assert.Empty(t, differences)
+}
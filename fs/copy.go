@@ -0,0 +1,199 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// copyOptions configure CopyFile
+type copyOptions struct {
+	overwrite     bool
+	preserveMode  bool
+	preserveTimes bool
+	sync          bool
+	progress      func(written int64, total int64)
+	ignore        *IgnoreMatcher
+}
+
+// CopyOption configures CopyFile
+type CopyOption func(*copyOptions)
+
+// CopyOverwrite allows CopyFile to replace an existing destination file (default false)
+func CopyOverwrite(overwrite bool) CopyOption {
+	return func(o *copyOptions) {
+		o.overwrite = overwrite
+	}
+}
+
+// CopyPreserveMode copies the permissions of the source file (default true)
+func CopyPreserveMode(preserve bool) CopyOption {
+	return func(o *copyOptions) {
+		o.preserveMode = preserve
+	}
+}
+
+// CopyPreserveTimes copies the modification time of the source file (default true)
+func CopyPreserveTimes(preserve bool) CopyOption {
+	return func(o *copyOptions) {
+		o.preserveTimes = preserve
+	}
+}
+
+// CopySync flushes the destination file to disk before returning (default false)
+func CopySync(sync bool) CopyOption {
+	return func(o *copyOptions) {
+		o.sync = sync
+	}
+}
+
+// CopyProgress calls progress with the bytes written so far and the total size
+// of the source file as the copy proceeds (default none)
+func CopyProgress(progress func(written int64, total int64)) CopyOption {
+	return func(o *copyOptions) {
+		o.progress = progress
+	}
+}
+
+// CopyIgnore skips the files and directories matched by an IgnoreMatcher when
+// copying a directory with CopyDirectory (default none)
+func CopyIgnore(ignore *IgnoreMatcher) CopyOption {
+	return func(o *copyOptions) {
+		o.ignore = ignore
+	}
+}
+
+// CopyFile simply copies the contents of a regular file to a destination path,
+// preserving the file mode and modification time by default. CopyFile fails
+// if the destination already exists unless CopyOverwrite(true) is set.
+func CopyFile(src string, dst string, opts ...CopyOption) error {
+
+	// Apply Copy Options
+	options := copyOptions{preserveMode: true, preserveTimes: true}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// Check IF Source File Exists
+	info, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("File '%v' doesn't exist", src)
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("File '%v' is not a regular file", src)
+	}
+
+	// Check IF Destination File Exists (truncating the source itself would lose its contents)
+	dstInfo, err := os.Stat(dst)
+	if err == nil && !options.overwrite {
+		return fmt.Errorf("File '%v' already exists", dst)
+	}
+	if err == nil && os.SameFile(info, dstInfo) {
+		return fmt.Errorf("File '%v' is the same file as '%v'", dst, src)
+	}
+
+	// Open Source File
+	source, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	// Create Destination File
+	mode := os.FileMode(0666)
+	if options.preserveMode {
+		mode = info.Mode().Perm()
+	}
+	destination, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	// Copy File Contents
+	err = copyContents(destination, source, options)
+	if closeErr := destination.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(dst)
+		return err
+	}
+
+	// Set File Permissions (not subject to umask)
+	if options.preserveMode {
+		err = os.Chmod(dst, mode)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Set File Modification Time
+	if options.preserveTimes {
+		err = os.Chtimes(dst, info.ModTime(), info.ModTime())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CopyDirectory simply copies a directory tree to a destination path, recreating
+// subdirectories and symbolic links and copying regular files with CopyFile (the
+// CopyOptions apply to each file). Paths matched by CopyIgnore are skipped.
+func CopyDirectory(src string, dst string, opts ...CopyOption) error {
+
+	// Check IF Source Directory Exists
+	info, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("Directory '%v' doesn't exist", src)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("Directory '%v' is not a directory", src)
+	}
+
+	// Copy Directory Tree
+	return copyTree(src, dst, opts...)
+}
+
+// copyContents streams the source file into the destination file, preserving
+// the holes of sparse source files where the platform can detect them
+func copyContents(destination *os.File, source *os.File, options copyOptions) error {
+
+	info, err := source.Stat()
+	if err != nil {
+		return err
+	}
+
+	// Report Copy Progress
+	var writer io.Writer = destination
+	if options.progress != nil {
+		options.progress(0, info.Size())
+		writer = &progressWriter{writer: destination, total: info.Size(), progress: options.progress}
+	}
+
+	// Copy File
+	if ranges, ok := dataRanges(source, info.Size()); ok {
+		err = copyRanges(destination, source, writer, ranges, info.Size())
+	} else {
+		_, err = io.Copy(writer, source)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Save File Changes
+	if options.sync {
+		return destination.Sync()
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCopyFile is a unit test for fs.CopyFile()
+func TestCopyFile(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "run.sh")
+	assert.NoError(t, OverwriteFile(src, 0750, []byte("#!/bin/sh")))
+	modified := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, os.Chtimes(src, modified, modified))
+
+	// Assert Unit Test
+	dst := filepath.Join(dir, "copy.sh")
+	assert.NoError(t, CopyFile(src, dst, CopySync(true)))
+	data, err := os.ReadFile(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh", string(data))
+	info, err := os.Stat(dst)
+	assert.NoError(t, err)
+	assert.True(t, modified.Equal(info.ModTime()), info.ModTime())
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0750), info.Mode().Perm())
+	}
+
+	// Assert Mode and Modification Time are not Preserved
+	plain := filepath.Join(dir, "plain.sh")
+	assert.NoError(t, CopyFile(src, plain, CopyPreserveMode(false), CopyPreserveTimes(false)))
+	info, err = os.Stat(plain)
+	assert.NoError(t, err)
+	assert.False(t, modified.Equal(info.ModTime()))
+	if runtime.GOOS != "windows" {
+		assert.NotEqual(t, os.FileMode(0750), info.Mode().Perm())
+	}
+
+	// Assert an Existing Destination is only Replaced with CopyOverwrite
+	assert.NoError(t, OverwriteFile(src, 0750, []byte("#!/bin/bash")))
+	assert.ErrorContains(t, CopyFile(src, dst), "already exists")
+	data, err = os.ReadFile(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh", string(data))
+	assert.NoError(t, CopyFile(src, dst, CopyOverwrite(true)))
+	data, err = os.ReadFile(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, "#!/bin/bash", string(data))
+}
+
+// TestCopyFileErrors is a unit test for fs.CopyFile() rejecting invalid sources and destinations
+func TestCopyFileErrors(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "a.txt")
+	assert.NoError(t, OverwriteFile(src, 0644, []byte("alpha")))
+
+	// Assert Unit Test
+	assert.ErrorContains(t, CopyFile(filepath.Join(dir, "missing.txt"), filepath.Join(dir, "b.txt")), "doesn't exist")
+	assert.ErrorContains(t, CopyFile(dir, filepath.Join(dir, "b.txt")), "is not a regular file")
+	assert.Error(t, CopyFile(src, filepath.Join(dir, "missing", "b.txt")))
+	assert.NoFileExists(t, filepath.Join(dir, "b.txt"))
+
+	// Assert the Source is not Copied onto Itself
+	assert.ErrorContains(t, CopyFile(src, src, CopyOverwrite(true)), "is the same file as")
+	for _, link := range []func(string, string) error{os.Symlink, os.Link} {
+		dst := filepath.Join(dir, "link.txt")
+		os.Remove(dst)
+		if link(src, dst) == nil {
+			assert.ErrorContains(t, CopyFile(src, dst, CopyOverwrite(true)), "is the same file as")
+		}
+	}
+	data, err := os.ReadFile(src)
+	assert.NoError(t, err)
+	assert.Equal(t, "alpha", string(data))
+}
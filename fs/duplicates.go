@@ -0,0 +1,97 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"sort"
+	"sync"
+)
+
+// FindDuplicates returns the regular files in a directory tree with identical contents,
+// grouped by SHA256 checksum (only groups of two or more files, each sorted). Files are
+// first grouped by size so only files sharing a size are hashed. Empty files are ignored.
+// Optional WalkOptions filter the files compared, and WalkOptions.Concurrency sets the
+// number of files walked and hashed in parallel.
+func FindDuplicates(root string, options ...WalkOptions) (map[string][]string, error) {
+
+	walkOptions := WalkOptions{}
+	if len(options) > 0 {
+		walkOptions = options[0]
+	}
+
+	// Group Files by Size
+	var mutex sync.Mutex
+	sizes := map[int64][]string{}
+	err := Walk(root, walkOptions, func(path string, info os.FileInfo) error {
+		if info.Mode().IsRegular() && info.Size() > 0 {
+			mutex.Lock()
+			sizes[info.Size()] = append(sizes[info.Size()], path)
+			mutex.Unlock()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect Files sharing a Size
+	candidates := make(chan string)
+	go func() {
+		defer close(candidates)
+		for _, paths := range sizes {
+			if len(paths) > 1 {
+				for _, path := range paths {
+					candidates <- path
+				}
+			}
+		}
+	}()
+
+	// Hash Candidate Files
+	workers := walkOptions.Concurrency
+	if workers < 1 {
+		workers = 1
+	}
+	hashes := map[string][]string{}
+	var wait sync.WaitGroup
+	var hashErr error
+	for i := 0; i < workers; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			for path := range candidates {
+				sum, err := Checksum(path, SHA256)
+				mutex.Lock()
+				if err != nil && hashErr == nil {
+					hashErr = err
+				}
+				if err == nil {
+					hashes[sum] = append(hashes[sum], path)
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+	wait.Wait()
+	if hashErr != nil {
+		return nil, hashErr
+	}
+
+	// Keep Groups of Duplicates
+	duplicates := map[string][]string{}
+	for sum, paths := range hashes {
+		if len(paths) > 1 {
+			sort.Strings(paths)
+			duplicates[sum] = paths
+		}
+	}
+
+	return duplicates, nil
+}
@@ -0,0 +1,41 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFindDuplicates is a unit test for fs.FindDuplicates()
+func TestFindDuplicates(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, EnsureDirectory(filepath.Join(dir, "copy"), 0755))
+	files := map[string]string{
+		"a.txt":      "duplicate",
+		"copy/a.txt": "duplicate",
+		"b.txt":      "different",
+		"c.txt":      "unique size",
+	}
+	for name, data := range files {
+		assert.NoError(t, OverwriteFile(filepath.Join(dir, name), 0644, []byte(data)))
+	}
+	assert.NoError(t, Touch(filepath.Join(dir, "empty1")))
+	assert.NoError(t, Touch(filepath.Join(dir, "empty2")))
+
+	duplicates, err := FindDuplicates(dir, WalkOptions{Concurrency: 4})
+	// Assert Unit Test
+	assert.NoError(t, err)
+	sum, _ := Checksum(filepath.Join(dir, "a.txt"), SHA256)
+	assert.Equal(t, map[string][]string{
+		sum: {filepath.Join(dir, "a.txt"), filepath.Join(dir, "copy", "a.txt")},
+	}, duplicates)
+}
@@ -0,0 +1,129 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testDocument is the value written and read by encoding unit tests
+type testDocument struct {
+	Name  string   `json:"name" yaml:"name" toml:"name"`
+	Count int      `json:"count" yaml:"count" toml:"count"`
+	Tags  []string `json:"tags" yaml:"tags" toml:"tags"`
+}
+
+// TestJSON is a unit test for fs.WriteJSON() and fs.ReadJSON()
+func TestJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.json")
+	expected := testDocument{Name: "gogo", Count: 2, Tags: []string{"a", "b"}}
+
+	assert.NoError(t, WriteJSON(path, expected, 0644, JSONPretty(false)))
+	data, _ := os.ReadFile(path)
+	// Assert Unit Test
+	assert.Equal(t, "{\"name\":\"gogo\",\"count\":2,\"tags\":[\"a\",\"b\"]}\n", string(data))
+
+	var actual testDocument
+	assert.NoError(t, ReadJSON(path, &actual))
+	assert.Equal(t, expected, actual)
+
+	// Assert only a Missing File doesn't exist
+	missing := filepath.Join(filepath.Dir(path), "missing.json")
+	assert.EqualError(t, ReadJSON(missing, &actual), "File '"+missing+"' doesn't exist")
+	err := ReadJSON(filepath.Dir(path), &actual)
+	var pathErr *os.PathError
+	assert.True(t, errors.As(err, &pathErr), err)
+	assert.NotContains(t, err.Error(), "doesn't exist")
+}
+
+// TestYAML is a unit test for fs.WriteYAML() and fs.ReadYAML()
+func TestYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.yaml")
+	expected := testDocument{Name: "gogo", Count: 2, Tags: []string{"a", "b"}}
+
+	assert.NoError(t, WriteYAML(path, expected, 0644))
+	data, _ := os.ReadFile(path)
+	// Assert Unit Test
+	assert.Equal(t, "name: gogo\ncount: 2\ntags:\n  - a\n  - b\n", string(data))
+
+	var actual testDocument
+	assert.NoError(t, ReadYAML(path, &actual))
+	assert.Equal(t, expected, actual)
+
+	// Assert only a Missing File doesn't exist
+	missing := filepath.Join(filepath.Dir(path), "missing.yaml")
+	assert.EqualError(t, ReadYAML(missing, &actual), "File '"+missing+"' doesn't exist")
+	err := ReadYAML(filepath.Dir(path), &actual)
+	var pathErr *os.PathError
+	assert.True(t, errors.As(err, &pathErr), err)
+	assert.NotContains(t, err.Error(), "doesn't exist")
+}
+
+// TestTOML is a unit test for fs.WriteTOML() and fs.ReadTOML()
+func TestTOML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.toml")
+	expected := testDocument{Name: "gogo", Count: 2, Tags: []string{"a", "b"}}
+
+	assert.NoError(t, WriteTOML(path, expected, 0644))
+	data, _ := os.ReadFile(path)
+	// Assert Unit Test
+	assert.Equal(t, "name = \"gogo\"\ncount = 2\ntags = [\"a\", \"b\"]\n", string(data))
+
+	var actual testDocument
+	assert.NoError(t, ReadTOML(path, &actual))
+	assert.Equal(t, expected, actual)
+
+	// Assert only a Missing File doesn't exist
+	missing := filepath.Join(filepath.Dir(path), "missing.toml")
+	assert.EqualError(t, ReadTOML(missing, &actual), "File '"+missing+"' doesn't exist")
+	err := ReadTOML(filepath.Dir(path), &actual)
+	var pathErr *os.PathError
+	assert.True(t, errors.As(err, &pathErr), err)
+	assert.NotContains(t, err.Error(), "doesn't exist")
+}
+
+// TestReadFileAuto is a unit test for fs.WriteFileGzip() and fs.ReadFileAuto()
+func TestReadFileAuto(t *testing.T) {
+	dir := t.TempDir()
+	compressed := filepath.Join(dir, "app.log.gz")
+	plain := filepath.Join(dir, "app.log")
+	assert.NoError(t, WriteFileGzip(compressed, []byte("compressed log"), 0644))
+	assert.NoError(t, OverwriteFile(plain, 0644, []byte("plain log")))
+
+	data, err := ReadFileAuto(compressed)
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, "compressed log", string(data))
+
+	data, err = ReadFileAuto(plain)
+	assert.NoError(t, err)
+	assert.Equal(t, "plain log", string(data))
+}
+
+// TestWriteTemplate is a unit test for fs.WriteTemplate() and fs.ReadFileString()
+func TestWriteTemplate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "README.md")
+
+	err := WriteTemplate(path, "# {{ .Name }}\n", map[string]string{"Name": "gogo"}, 0644)
+	// Assert Unit Test
+	assert.NoError(t, err)
+	content, err := ReadFileString(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "# gogo\n", content)
+
+	err = WriteTemplate(path, "# {{ .Missing }}\n", map[string]string{}, 0644)
+	assert.Error(t, err)
+	content, _ = ReadFileString(path)
+	assert.Equal(t, "# gogo\n", content)
+}
@@ -11,36 +11,66 @@ package fs
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/mitchellh/go-homedir"
 )
 
+// compoundExtensions are the multi-part extensions recognized by FileExtensions
+var compoundExtensions = []string{"tar.gz", "tar.bz2", "tar.xz", "tar.zst", "tar.lz4", "tar.br", "tar.z"}
+
 // FileExtension simply returns the Extension from a File Path
 // in format of <filename>.<extension> (e.g. .json|.yaml|.txt etc)
-func FileExtension(filepath string) (string, error) {
-
-	// Check Filepath has .
-	valid := strings.Contains(filepath, ".")
-	if valid == true {
-		// Split Path into a Slice
-		fSlice := strings.Split(filepath, ".")
+// Dots in parent directories are ignored and dotfiles without
+// a further extension (e.g. .gitignore) have no extension
+func FileExtension(path string) (string, error) {
 
-		// Get the Length of the Slice
-		fSliceLen := len(fSlice)
-
-		// Get the extension of the Filepath from last index position in the Slice (length -1)
-		fileExtension := fSlice[fSliceLen-1]
+	// Get File Name (ignoring Parent Directories)
+	name := fileName(path)
 
+	// Check File Name has . after the first character
+	index := strings.LastIndex(name, ".")
+	if index > 0 && index < len(name)-1 {
 		// Return the Extension
-		return strings.ToLower(fileExtension), nil
+		return strings.ToLower(name[index+1:]), nil
 	}
 	return "", fmt.Errorf("Failed to find File Extension. Filepath must be in format of <filename>.<ext>")
 
 }
 
+// FileExtensions simply returns the Extension from a File Path like FileExtension,
+// recognizing compound extensions such as tar.gz and tar.bz2
+func FileExtensions(path string) (string, error) {
+
+	extension, err := FileExtension(path)
+	if err != nil {
+		return "", err
+	}
+
+	// Check for Compound Extension
+	name := strings.ToLower(fileName(path))
+	for _, compound := range compoundExtensions {
+		if strings.HasSuffix(name, "."+compound) && len(name) > len(compound)+1 {
+			return compound, nil
+		}
+	}
+
+	return extension, nil
+}
+
+// fileName returns the last element of a path using either separator
+func fileName(path string) string {
+	if index := strings.LastIndexAny(path, `/\`); index >= 0 {
+		return path[index+1:]
+	}
+	return path
+}
+
 // PathExists simply checks if a path exists on the filesystem
 // Returns TRUE if the path does exist
 // Returns FALSE if the path does *not* exist
@@ -100,6 +130,60 @@ func CreateDirectory(path string, mode os.FileMode) error {
 	return nil
 }
 
+// EnsureDirectory simply creates a directory and any missing parent
+// directories, succeeding if the directory already exists
+func EnsureDirectory(path string, mode os.FileMode) error {
+
+	// Check IF Path Exists
+	info, err := os.Stat(path)
+	if err == nil {
+		if !info.IsDir() {
+			return fmt.Errorf("Path '%v' already exists and is not a directory", path)
+		}
+		return nil
+	}
+
+	// Create Directory
+	err = os.MkdirAll(path, mode)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsEmptyDir simply checks if a directory has no entries
+// Returns TRUE if the directory is empty
+// Returns FALSE if the directory has any files or subdirectories
+func IsEmptyDir(path string) (bool, error) {
+
+	// Check IF Directory Exists
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, fmt.Errorf("Directory '%v' doesn't exist", path)
+	}
+	if !info.IsDir() {
+		return false, fmt.Errorf("Directory '%v' is not a directory", path)
+	}
+
+	// Read First Directory Entry
+	dir, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer dir.Close()
+
+	_, err = dir.Readdirnames(1)
+	if err == io.EOF {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
 // DeleteDirectory simply checks if the directory path already
 // exists before attempting to delete the directory
 func DeleteDirectory(path string) error {
@@ -164,6 +248,43 @@ func CreateFile(path string, mode os.FileMode) error {
 	return nil
 }
 
+// Touch simply creates an empty file if the path doesn't exist,
+// or updates the access and modification times if it does exist
+func Touch(path string) error {
+
+	// Check IF File Exists
+	_, err := os.Stat(path)
+	if err == nil {
+		// Update Access and Modification Times
+		now := time.Now()
+		return os.Chtimes(path, now, now)
+	}
+	if !os.IsNotExist(err) {
+		return err
+	}
+
+	// Create File
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+// TouchAll simply creates any missing parent directories
+// before attempting to touch the file
+func TouchAll(path string) error {
+
+	// Create Parent Directories
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	return Touch(path)
+}
+
 // DeleteFile simply deletes a file if it exists
 func DeleteFile(path string) error {
 
@@ -241,6 +362,90 @@ func WriteFile(path string, mode os.FileMode, data []byte) error {
 	return nil
 }
 
+// ReadFileString simply reads a file like ReadFile, returning its contents as a string
+func ReadFileString(path string) (string, error) {
+	data, err := ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// WriteFileString simply writes a string to a new file like WriteFile
+func WriteFileString(path string, mode os.FileMode, content string) error {
+	return WriteFile(path, mode, []byte(content))
+}
+
+// OverwriteFile simply creates and writes the file, replacing
+// the contents of the file if it already exists
+func OverwriteFile(path string, mode os.FileMode, data []byte) error {
+
+	// Create or Truncate File
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Write File
+	_, err = file.Write(data)
+	if err != nil {
+		return err
+	}
+
+	// Save File Changes
+	err = file.Sync()
+	if err != nil {
+		return err
+	}
+
+	// Set File Permissions
+	err = os.Chmod(path, mode)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AppendFile simply appends data to the end of the file, creating
+// the file with the given mode if it doesn't exist
+func AppendFile(path string, mode os.FileMode, data []byte) error {
+
+	// Check IF File Exists
+	_, err := os.Stat(path)
+	created := os.IsNotExist(err)
+
+	// Open File for Appending
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Write File
+	_, err = file.Write(data)
+	if err != nil {
+		return err
+	}
+
+	// Save File Changes
+	err = file.Sync()
+	if err != nil {
+		return err
+	}
+
+	// Set File Permissions (only when the File was created)
+	if created {
+		err = os.Chmod(path, mode)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // HomeDirectory returns the home directory for the executing user.
 // This uses an OS-specific method for discovering the home directory.
 // An error is returned if a home directory cannot be detected.
@@ -0,0 +1,142 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFileExtension is a unit test for fs.FileExtension() and fs.FileExtensions()
+func TestFileExtension(t *testing.T) {
+	tests := []struct {
+		path       string
+		extension  string
+		extensions string
+	}{
+		{"config.JSON", "json", "json"},
+		{"release/app-1.2.tar.gz", "gz", "tar.gz"},
+		{"backup.TAR.BZ2", "bz2", "tar.bz2"},
+		{"dir.d/.gitignore", "", ""},
+		{".config.yaml", "yaml", "yaml"},
+		{"v1.2/Makefile", "", ""},
+		{`C:\dir.d\file`, "", ""},
+		{".tar.gz", "gz", "gz"},
+	}
+	for _, test := range tests {
+		extension, err := FileExtension(test.path)
+		// Assert Unit Test
+		assert.Equal(t, test.extension, extension, test.path)
+		assert.Equal(t, test.extension == "", err != nil, test.path)
+
+		extensions, _ := FileExtensions(test.path)
+		assert.Equal(t, test.extensions, extensions, test.path)
+	}
+}
+
+// TestAppendFile is a unit test for fs.AppendFile() and fs.OverwriteFile()
+func TestAppendFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	// Assert Unit Test
+	assert.NoError(t, AppendFile(path, 0600, []byte("one\n")))
+	assert.NoError(t, AppendFile(path, 0644, []byte("two\n")))
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "one\ntwo\n", string(data))
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	// Assert Overwrite replaces the Contents and Mode
+	assert.NoError(t, OverwriteFile(path, 0644, []byte("three\n")))
+	data, err = os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "three\n", string(data))
+	info, err = os.Stat(path)
+	assert.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+	}
+
+	// Assert Missing Directories are not Created
+	missing := filepath.Join(filepath.Dir(path), "missing", "app.log")
+	assert.Error(t, AppendFile(missing, 0644, []byte("one\n")))
+	assert.Error(t, OverwriteFile(missing, 0644, []byte("one\n")))
+	assert.NoFileExists(t, missing)
+}
+
+// TestTouch is a unit test for fs.Touch() and fs.TouchAll()
+func TestTouch(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "notes.txt")
+
+	// Assert Unit Test
+	assert.NoError(t, Touch(path))
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+
+	// Assert an Existing File only has its Modification Time updated
+	assert.NoError(t, OverwriteFile(path, 0600, []byte("keep")))
+	lastWeek := time.Now().Add(-7 * 24 * time.Hour).Truncate(time.Second)
+	assert.NoError(t, os.Chtimes(path, lastWeek, lastWeek))
+	assert.NoError(t, Touch(path))
+	data, err = os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "keep", string(data))
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.True(t, info.ModTime().After(lastWeek.Add(time.Hour)), info.ModTime())
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	// Assert Missing Parent Directories are only Created by TouchAll
+	nested := filepath.Join(dir, "a", "b", "notes.txt")
+	assert.Error(t, Touch(nested))
+	assert.NoDirExists(t, filepath.Join(dir, "a"))
+	assert.NoError(t, TouchAll(nested))
+	assert.FileExists(t, nested)
+}
+
+// TestEnsureDirectory is a unit test for fs.EnsureDirectory() and fs.IsEmptyDir()
+func TestEnsureDirectory(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a", "b")
+
+	// Assert Unit Test
+	assert.NoError(t, EnsureDirectory(path, 0755))
+	assert.NoError(t, EnsureDirectory(path, 0755))
+	assert.DirExists(t, path)
+	empty, err := IsEmptyDir(path)
+	assert.NoError(t, err)
+	assert.True(t, empty)
+
+	// Assert a Hidden File is an Entry
+	assert.NoError(t, OverwriteFile(filepath.Join(path, ".keep"), 0644, nil))
+	empty, err = IsEmptyDir(path)
+	assert.NoError(t, err)
+	assert.False(t, empty)
+
+	// Assert Files are not Directories
+	file := filepath.Join(path, ".keep")
+	assert.ErrorContains(t, EnsureDirectory(file, 0755), "is not a directory")
+	_, err = IsEmptyDir(file)
+	assert.ErrorContains(t, err, "is not a directory")
+	_, err = IsEmptyDir(filepath.Join(dir, "missing"))
+	assert.ErrorContains(t, err, "doesn't exist")
+}
@@ -0,0 +1,110 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"sort"
+	"time"
+)
+
+// FileType identifies the type of a file for Criteria
+type FileType int
+
+// File Types
+const (
+	TypeAny     FileType = iota // any type
+	TypeFile                    // regular files
+	TypeDir                     // directories
+	TypeSymlink                 // symbolic links
+)
+
+// Criteria select the paths returned by Find, zero values are ignored
+type Criteria struct {
+	NamePattern string        // pattern matched against the base name (e.g. "*.{log,tmp}")
+	OlderThan   time.Duration // modified more than the duration ago
+	NewerThan   time.Duration // modified less than the duration ago
+	MinSize     int64         // at least MinSize bytes
+	MaxSize     int64         // at most MaxSize bytes
+	Type        FileType      // type of file
+}
+
+// Find simply returns the sorted paths below root (excluding root) that match all Criteria,
+// e.g. Criteria{OlderThan: 30 * 24 * time.Hour, MinSize: 100 << 20, Type: TypeFile}
+func Find(root string, criteria Criteria) ([]string, error) {
+
+	// Validate Name Pattern
+	if criteria.NamePattern != "" {
+		_, err := Match(criteria.NamePattern, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	matches := []string{}
+	err := Walk(root, WalkOptions{}, func(path string, info os.FileInfo) error {
+		if path != root && criteria.matches(info, now) {
+			matches = append(matches, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// matches reports whether a file matches all Criteria
+func (criteria Criteria) matches(info os.FileInfo, now time.Time) bool {
+
+	// Match File Type
+	switch criteria.Type {
+	case TypeFile:
+		if !info.Mode().IsRegular() {
+			return false
+		}
+	case TypeDir:
+		if !info.IsDir() {
+			return false
+		}
+	case TypeSymlink:
+		if info.Mode()&os.ModeSymlink == 0 {
+			return false
+		}
+	}
+
+	// Match File Name
+	if criteria.NamePattern != "" {
+		if ok, _ := Match(criteria.NamePattern, info.Name()); !ok {
+			return false
+		}
+	}
+
+	// Match Modification Time
+	age := now.Sub(info.ModTime())
+	if criteria.OlderThan > 0 && age <= criteria.OlderThan {
+		return false
+	}
+	if criteria.NewerThan > 0 && age >= criteria.NewerThan {
+		return false
+	}
+
+	// Match File Size
+	if criteria.MinSize > 0 && info.Size() < criteria.MinSize {
+		return false
+	}
+	if criteria.MaxSize > 0 && info.Size() > criteria.MaxSize {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,42 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFind is a unit test for fs.Find()
+func TestFind(t *testing.T) {
+	dir := t.TempDir()
+	old := filepath.Join(dir, "logs", "old.log")
+	recent := filepath.Join(dir, "logs", "recent.log")
+	assert.NoError(t, TouchAll(old))
+	assert.NoError(t, OverwriteFile(recent, 0644, []byte("recent")))
+	lastMonth := time.Now().Add(-31 * 24 * time.Hour)
+	assert.NoError(t, os.Chtimes(old, lastMonth, lastMonth))
+
+	matches, err := Find(dir, Criteria{NamePattern: "*.log", OlderThan: 30 * 24 * time.Hour})
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, []string{old}, matches)
+
+	matches, err = Find(dir, Criteria{MinSize: 1, Type: TypeFile})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{recent}, matches)
+
+	matches, err = Find(dir, Criteria{Type: TypeDir})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "logs")}, matches)
+}
@@ -0,0 +1,56 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FindUp walks from start up through its parent directories and returns the first
+// directory containing any of the marker files or directories (e.g. ".git", "go.mod"
+// or "package.json"), the standard way to locate a project root
+func FindUp(start string, markers ...string) (string, error) {
+
+	// Validate Markers
+	if len(markers) == 0 {
+		return "", fmt.Errorf("The 'markers' parameter was empty. At least one marker is required")
+	}
+
+	// Start from a Directory
+	dir, err := filepath.Abs(start)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return "", fmt.Errorf("Path '%v' doesn't exist", start)
+	}
+	if !info.IsDir() {
+		dir = filepath.Dir(dir)
+	}
+
+	// Walk Parent Directories
+	for {
+		for _, marker := range markers {
+			if _, err := os.Lstat(filepath.Join(dir, marker)); err == nil {
+				return dir, nil
+			}
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	return "", fmt.Errorf("No parent of '%v' contains %v", start, strings.Join(markers, ", "))
+}
@@ -0,0 +1,108 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// PathExistsFold simply checks if a path exists on the filesystem ignoring case,
+// as it would on a case-insensitive filesystem (macOS/Windows)
+// Returns TRUE if the path or a path differing only in case does exist
+// Returns FALSE if no such path exists
+func PathExistsFold(path string) (bool, error) {
+	folded, err := foldedPath(filepath.Clean(path))
+	if err != nil {
+		return false, err
+	}
+	return folded != "", nil
+}
+
+// foldedPath returns the path on disk matching a path ignoring case, or "" if none exists
+func foldedPath(path string) (string, error) {
+
+	// Check IF Path Exists with Exact Case
+	if _, err := os.Lstat(path); err == nil {
+		return path, nil
+	}
+
+	// Find Parent Directory ignoring Case
+	dir, name := filepath.Split(path)
+	dir = filepath.Clean(dir)
+	if dir == path || name == "" {
+		return "", nil
+	}
+	dir, err := foldedPath(dir)
+	if dir == "" || err != nil {
+		return "", err
+	}
+
+	// Match Directory Entries ignoring Case
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", nil
+	}
+	for _, entry := range entries {
+		if strings.EqualFold(entry.Name(), name) {
+			return filepath.Join(dir, entry.Name()), nil
+		}
+	}
+
+	return "", nil
+}
+
+// DetectCaseConflicts returns the groups of paths in a directory tree whose names
+// differ only in case (e.g. README.md and readme.md), which cannot coexist on a
+// case-insensitive filesystem. Groups and the paths in each group are sorted.
+func DetectCaseConflicts(dir string) ([][]string, error) {
+
+	// Check IF Directory Exists
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("Directory '%v' doesn't exist", dir)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("Directory '%v' is not a directory", dir)
+	}
+
+	// Group Paths by Directory and Folded Name
+	groups := map[string][]string{}
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+		key := filepath.Join(filepath.Dir(path), strings.ToLower(info.Name()))
+		groups[key] = append(groups[key], path)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect Conflicting Groups
+	conflicts := [][]string{}
+	for _, paths := range groups {
+		if len(paths) > 1 {
+			sort.Strings(paths)
+			conflicts = append(conflicts, paths)
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i][0] < conflicts[j][0]
+	})
+
+	return conflicts, nil
+}
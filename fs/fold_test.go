@@ -0,0 +1,50 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPathExistsFold is a unit test for fs.PathExistsFold()
+func TestPathExistsFold(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, TouchAll(filepath.Join(dir, "Docs", "README.md")))
+
+	// Assert Unit Test
+	for path, expected := range map[string]bool{
+		filepath.Join(dir, "Docs", "README.md"): true,
+		filepath.Join(dir, "docs", "readme.md"): true,
+		filepath.Join(dir, "DOCS"):              true,
+		filepath.Join(dir, "docs", "notes.md"):  false,
+	} {
+		exists, err := PathExistsFold(path)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, exists, path)
+	}
+}
+
+// TestDetectCaseConflicts is a unit test for fs.DetectCaseConflicts()
+func TestDetectCaseConflicts(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, TouchAll(filepath.Join(dir, "README.md")))
+	if exists, _ := PathExists(filepath.Join(dir, "readme.md")); exists {
+		t.Skip("filesystem is case-insensitive")
+	}
+	assert.NoError(t, TouchAll(filepath.Join(dir, "readme.md")))
+	assert.NoError(t, TouchAll(filepath.Join(dir, "src", "main.go")))
+
+	conflicts, err := DetectCaseConflicts(dir)
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{filepath.Join(dir, "README.md"), filepath.Join(dir, "readme.md")}}, conflicts)
+}
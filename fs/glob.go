@@ -0,0 +1,264 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Glob returns the paths matching one or more patterns, sorted and without duplicates.
+// Patterns use forward slashes on every platform and the syntax of path.Match, plus
+// "**" to match zero or more path segments (e.g. "src/**/*.go") and "{a,b}" to match
+// alternatives (e.g. "docs/*.{md,txt}"). Patterns starting with "!" exclude paths
+// from the result (e.g. "!**/vendor/**").
+func Glob(patterns ...string) ([]string, error) {
+
+	// Expand Braces and split Include/Exclude Patterns
+	var includes, excludes []string
+	for _, pattern := range patterns {
+		negate := strings.HasPrefix(pattern, "!")
+		for _, expanded := range expandBraces(strings.TrimPrefix(pattern, "!")) {
+			// Clean Pattern (walked paths are clean, e.g. "./src//*.go" matches as "src/*.go")
+			expanded = path.Clean(expanded)
+			err := validatePattern(expanded)
+			if err != nil {
+				return nil, err
+			}
+			if negate {
+				excludes = append(excludes, expanded)
+			} else {
+				includes = append(includes, expanded)
+			}
+		}
+	}
+
+	// Find Matches for each Include Pattern
+	found := map[string]bool{}
+	for _, pattern := range includes {
+		matches, err := globPattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			found[match] = true
+		}
+	}
+
+	// Remove Excluded Matches
+	result := []string{}
+	for match := range found {
+		excluded := false
+		for _, pattern := range excludes {
+			if ok, _ := matchPattern(pattern, filepath.ToSlash(match)); ok {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			result = append(result, match)
+		}
+	}
+
+	sort.Strings(result)
+	return result, nil
+}
+
+// Match reports whether a slash-separated path matches a pattern, using the
+// same syntax as Glob (including ** and {a,b}, but not ! negation)
+func Match(pattern string, name string) (bool, error) {
+	for _, expanded := range expandBraces(pattern) {
+		ok, err := matchPattern(expanded, name)
+		if ok || err != nil {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// globPattern walks the static prefix of a (brace expanded) pattern and returns matching paths
+func globPattern(pattern string) ([]string, error) {
+
+	// Find Static Base Directory (segments before the first wildcard)
+	segments := strings.Split(pattern, "/")
+	static := 0
+	for static < len(segments) && !hasMeta(segments[static]) {
+		static++
+	}
+	base := strings.Join(segments[:static], "/")
+	if static == len(segments) {
+		// No Wildcards: Check Path Exists
+		if _, err := os.Lstat(filepath.FromSlash(base)); err != nil {
+			return nil, nil
+		}
+		return []string{filepath.FromSlash(base)}, nil
+	}
+	root := base
+	if root == "" {
+		if strings.HasPrefix(pattern, "/") {
+			root = "/"
+		} else {
+			root = "."
+		}
+	}
+
+	// Walk Base Directory
+	var matches []string
+	err := filepath.Walk(filepath.FromSlash(root), func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsPermission(err) {
+				return nil
+			}
+			return err
+		}
+
+		name := filepath.ToSlash(p)
+		if base == "" && root == "." {
+			if name == "." {
+				return nil
+			}
+		}
+
+		// Skip Directories that cannot contain Matches
+		if info.IsDir() && !couldMatch(segments, strings.Split(name, "/")) {
+			return filepath.SkipDir
+		}
+
+		if ok, _ := matchPattern(pattern, name); ok {
+			matches = append(matches, p)
+		}
+		return nil
+	})
+
+	return matches, err
+}
+
+// matchPattern reports whether a slash-separated path matches a (brace expanded) pattern
+func matchPattern(pattern string, name string) (bool, error) {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+// matchSegments matches path segments against pattern segments, where **
+// matches zero or more segments
+func matchSegments(pattern []string, name []string) (bool, error) {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// Collapse Consecutive **
+			for len(pattern) > 1 && pattern[1] == "**" {
+				pattern = pattern[1:]
+			}
+			for i := 0; i <= len(name); i++ {
+				ok, err := matchSegments(pattern[1:], name[i:])
+				if ok || err != nil {
+					return ok, err
+				}
+			}
+			return false, nil
+		}
+
+		if len(name) == 0 {
+			return false, nil
+		}
+		ok, err := path.Match(pattern[0], name[0])
+		if !ok || err != nil {
+			return false, err
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0, nil
+}
+
+// couldMatch reports whether a directory could contain paths matching the pattern segments
+func couldMatch(pattern []string, dir []string) bool {
+
+	// The Root Directory "/" splits into two Empty Segments (the first matches the
+	// empty leading segment of an absolute pattern)
+	if len(dir) > 1 && dir[len(dir)-1] == "" {
+		dir = dir[:len(dir)-1]
+	}
+
+	for i, segment := range dir {
+		if i >= len(pattern) {
+			return false
+		}
+		if pattern[i] == "**" {
+			return true
+		}
+		if ok, _ := path.Match(pattern[i], segment); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// expandBraces expands {a,b} alternatives in a pattern (including nested braces)
+func expandBraces(pattern string) []string {
+
+	// Find the First Brace Group
+	start := strings.IndexByte(pattern, '{')
+	if start < 0 {
+		return []string{pattern}
+	}
+	depth := 0
+	end := -1
+	commas := []int{}
+	for i := start; i < len(pattern) && end < 0; i++ {
+		switch pattern[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				end = i
+			}
+		case ',':
+			if depth == 1 {
+				commas = append(commas, i)
+			}
+		}
+	}
+	if end < 0 {
+		return []string{pattern}
+	}
+
+	// Split Alternatives
+	var alternatives []string
+	last := start + 1
+	for _, comma := range commas {
+		alternatives = append(alternatives, pattern[last:comma])
+		last = comma + 1
+	}
+	alternatives = append(alternatives, pattern[last:end])
+
+	// Expand each Alternative with the rest of the Pattern
+	var result []string
+	for _, alternative := range alternatives {
+		result = append(result, expandBraces(pattern[:start]+alternative+pattern[end+1:])...)
+	}
+	return result
+}
+
+// validatePattern checks that every segment of a pattern is valid for path.Match
+func validatePattern(pattern string) error {
+	for _, segment := range strings.Split(pattern, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasMeta reports whether a path segment contains wildcard characters
+func hasMeta(segment string) bool {
+	return strings.ContainsAny(segment, `*?[\`)
+}
@@ -0,0 +1,122 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMatch is a unit test for fs.Match()
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{"*.go", "main.go", true},
+		{"*.go", "cmd/main.go", false},
+		{"**/*.go", "main.go", true},
+		{"**/*.go", "cmd/app/main.go", true},
+		{"src/**", "src/a/b", true},
+		{"src/**/test", "src/test", true},
+		{"docs/*.{md,txt}", "docs/README.md", true},
+		{"docs/*.{md,txt}", "docs/notes.txt", true},
+		{"docs/*.{md,txt}", "docs/image.png", false},
+		{"{a,b/{c,d}}/x", "b/d/x", true},
+	}
+	for _, test := range tests {
+		match, err := Match(test.pattern, test.name)
+		// Assert Unit Test
+		assert.NoError(t, err)
+		assert.Equal(t, test.match, match, "%v %v", test.pattern, test.name)
+	}
+}
+
+// TestGlob is a unit test for fs.Glob()
+func TestGlob(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.go", "b.md", "cmd/c.go", "vendor/d.go"} {
+		assert.NoError(t, TouchAll(filepath.Join(dir, name)))
+	}
+	base := filepath.ToSlash(dir)
+
+	matches, err := Glob(base+"/**/*.{go,md}", "!"+base+"/vendor/**")
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "a.go"),
+		filepath.Join(dir, "b.md"),
+		filepath.Join(dir, "cmd", "c.go"),
+	}, matches)
+
+	_, err = os.Stat(dir)
+	assert.NoError(t, err)
+
+	// Assert Patterns are Cleaned
+	cwd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir(dir))
+	defer os.Chdir(cwd)
+	for _, patterns := range [][]string{
+		{"./cmd/*.go"},
+		{"cmd//*.go"},
+		{"cmd/x/../*.go"},
+		{"./**/*.go", "!./a.go", "!vendor//**"},
+		{"**/c.go", "!./vendor/**"},
+	} {
+		matches, err = Glob(patterns...)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join("cmd", "c.go")}, matches, patterns)
+	}
+}
+
+// TestCouldMatch is a unit test for fs.couldMatch()
+func TestCouldMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		dir     string
+		match   bool
+	}{
+		{"src/*/main.go", "src", true},
+		{"src/*/main.go", "src/cmd", true},
+		{"src/*/main.go", "src/cmd/app", false},
+		{"src/**/*.go", "src/a/b/c", true},
+		{"docs/*.md", "src", false},
+		{"/*.go", "/", true},
+		{"/*.go", "/etc", false},
+		{"/etc/*.conf", "/etc", true},
+	}
+	for _, test := range tests {
+		// Assert Unit Test
+		match := couldMatch(strings.Split(test.pattern, "/"), strings.Split(test.dir, "/"))
+		assert.Equal(t, test.match, match, "%v %v", test.pattern, test.dir)
+	}
+}
+
+// TestGlobRoot is a unit test for fs.Glob() with a pattern at the root of the filesystem
+func TestGlobRoot(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses the root directory /")
+	}
+	top := "/" + strings.Split(filepath.ToSlash(t.TempDir()), "/")[1]
+
+	// Assert Unit Test
+	matches, err := Glob(top[:2] + "*")
+	assert.NoError(t, err)
+	assert.Contains(t, matches, top)
+	matches, err = Glob(top)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{top}, matches)
+}
@@ -0,0 +1,73 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+)
+
+// gzipMagic are the first bytes of every gzip stream
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// ReadFileAuto simply reads a file, transparently decompressing it
+// when the file contents are gzip compressed (e.g. app.log.gz)
+func ReadFileAuto(path string) ([]byte, error) {
+
+	// Check IF File Exists
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("File '%v' doesn't exist", path)
+	}
+	defer file.Close()
+
+	// Check for Gzip Header
+	reader := bufio.NewReader(file)
+	header, _ := reader.Peek(len(gzipMagic))
+	if !bytes.Equal(header, gzipMagic) {
+		return io.ReadAll(reader)
+	}
+
+	// Decompress File
+	gz, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("File '%v' is not valid gzip: %v", path, err)
+	}
+	defer gz.Close()
+
+	data, err := io.ReadAll(gz)
+	if err != nil {
+		return nil, fmt.Errorf("File '%v' is not valid gzip: %v", path, err)
+	}
+
+	return data, nil
+}
+
+// WriteFileGzip gzip compresses data and atomically writes it to a file, replacing any existing file
+func WriteFileGzip(path string, data []byte, mode os.FileMode) error {
+
+	// Compress Data
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write(data)
+	if err != nil {
+		return err
+	}
+	err = gz.Close()
+	if err != nil {
+		return err
+	}
+
+	// Write File
+	return WriteFileAtomic(path, mode, buf.Bytes())
+}
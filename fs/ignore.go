@@ -0,0 +1,146 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ignoreRule is a single .gitignore-style pattern
+type ignoreRule struct {
+	pattern  string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// IgnoreMatcher matches slash-separated paths relative to a root directory against
+// .gitignore-style rules: patterns without a slash match the name at any depth, a
+// leading slash (or a slash in the middle) anchors the pattern to the root, a
+// trailing slash matches directories only, ** matches any number of directories and
+// a leading ! re-includes paths excluded by an earlier rule. The last matching rule
+// wins. A nil IgnoreMatcher matches nothing.
+type IgnoreMatcher struct {
+	rules []ignoreRule
+}
+
+// NewIgnoreMatcher simply parses .gitignore-style patterns, skipping blank lines and
+// comments (a leading \ escapes a literal # or !)
+func NewIgnoreMatcher(patterns []string) *IgnoreMatcher {
+	matcher := &IgnoreMatcher{}
+	for _, pattern := range patterns {
+		pattern = strings.TrimRight(pattern, "\r")
+
+		// Trim Trailing Spaces (unless escaped)
+		if !strings.HasSuffix(pattern, "\\ ") {
+			pattern = strings.TrimRight(pattern, " \t")
+		}
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+
+		rule := ignoreRule{}
+		switch {
+		case strings.HasPrefix(pattern, "!"):
+			rule.negate = true
+			pattern = pattern[1:]
+		case strings.HasPrefix(pattern, "\\#"), strings.HasPrefix(pattern, "\\!"):
+			pattern = pattern[1:]
+		}
+		if strings.HasSuffix(pattern, "/") {
+			rule.dirOnly = true
+			pattern = strings.TrimSuffix(pattern, "/")
+		}
+		if strings.Contains(pattern, "/") {
+			rule.anchored = true
+			pattern = strings.TrimPrefix(pattern, "/")
+		}
+		if pattern == "" {
+			continue
+		}
+		rule.pattern = pattern
+		matcher.rules = append(matcher.rules, rule)
+	}
+	return matcher
+}
+
+// LoadIgnoreFile simply reads a .gitignore-style file and returns its IgnoreMatcher,
+// the patterns are matched against paths relative to the directory containing the file
+func LoadIgnoreFile(path string) (*IgnoreMatcher, error) {
+
+	// Read Ignore File
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("File '%v' doesn't exist", path)
+		}
+		return nil, err
+	}
+
+	return NewIgnoreMatcher(strings.Split(string(data), "\n")), nil
+}
+
+// Match reports whether a path relative to the root is ignored, either by the rules
+// matching the path itself or by the rules matching one of its parent directories
+// (as with git, a path inside an ignored directory can't be re-included)
+func (m *IgnoreMatcher) Match(rel string, isDir bool) bool {
+	if m == nil || len(m.rules) == 0 {
+		return false
+	}
+	rel = strings.TrimPrefix(path.Clean("/"+filepath.ToSlash(rel)), "/")
+	if rel == "" {
+		return false
+	}
+
+	// Check Parent Directories
+	for i := 0; i < len(rel); i++ {
+		if rel[i] == '/' && m.matches(rel[:i], true) {
+			return true
+		}
+	}
+
+	return m.matches(rel, isDir)
+}
+
+// Empty reports whether the IgnoreMatcher has no rules
+func (m *IgnoreMatcher) Empty() bool {
+	return m == nil || len(m.rules) == 0
+}
+
+// matches reports whether the rules match a slash-separated relative path itself,
+// the last matching rule wins
+func (m *IgnoreMatcher) matches(rel string, isDir bool) bool {
+	if m == nil {
+		return false
+	}
+	matched := false
+	for _, rule := range m.rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		if rule.matches(rel) {
+			matched = !rule.negate
+		}
+	}
+	return matched
+}
+
+// matches reports whether a single rule matches a relative path
+func (rule ignoreRule) matches(rel string) bool {
+	if rule.anchored {
+		ok, _ := Match(rule.pattern, rel)
+		return ok
+	}
+	ok, _ := Match(rule.pattern, path.Base(rel))
+	return ok
+}
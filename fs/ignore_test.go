@@ -0,0 +1,92 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIgnoreMatcher is a unit test for fs.NewIgnoreMatcher()
+func TestIgnoreMatcher(t *testing.T) {
+	matcher := NewIgnoreMatcher([]string{
+		"# build output",
+		"",
+		"*.log",
+		"!keep.log",
+		"/bin",
+		"node_modules/",
+		"docs/**/*.tmp",
+		"\\#notes",
+	})
+
+	tests := []struct {
+		path    string
+		isDir   bool
+		ignored bool
+	}{
+		{"app.log", false, true},
+		{"logs/debug.log", false, true},
+		{"logs/keep.log", false, false},
+		{"bin", true, true},
+		{"bin/app", false, true},
+		{"cmd/bin", true, false},
+		{"node_modules", true, true},
+		{"web/node_modules/lib/index.js", false, true},
+		{"node_modules", false, false},
+		{"docs/a/b/draft.tmp", false, true},
+		{"draft.tmp", false, false},
+		{"#notes", false, true},
+		{"main.go", false, false},
+	}
+
+	// Assert Unit Test
+	for _, test := range tests {
+		assert.Equal(t, test.ignored, matcher.Match(test.path, test.isDir), test.path)
+	}
+	var empty *IgnoreMatcher
+	assert.False(t, empty.Match("app.log", false))
+}
+
+// TestLoadIgnoreFile is a unit test for fs.LoadIgnoreFile()
+func TestLoadIgnoreFile(t *testing.T) {
+	root := walkTree(t)
+	assert.NoError(t, WriteFileString(filepath.Join(root, ".gitignore"), 0644, "vendor/\r\nbuild\n*_test.go  \n"))
+
+	matcher, err := LoadIgnoreFile(filepath.Join(root, ".gitignore"))
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		".env", ".gitignore", "README.md", "cmd", "cmd/app", "cmd/app/app.go", "main.go",
+	}, walkPaths(t, root, WalkOptions{Ignore: matcher}))
+
+	_, err = LoadIgnoreFile(filepath.Join(root, "missing"))
+	assert.Error(t, err)
+}
+
+// TestCopyDirectory is a unit test for fs.CopyDirectory()
+func TestCopyDirectory(t *testing.T) {
+	root := walkTree(t)
+	dst := filepath.Join(t.TempDir(), "copy")
+
+	err := CopyDirectory(root, dst, CopyIgnore(NewIgnoreMatcher([]string{"vendor/", "*.bin"})))
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		".env", "README.md", "build", "cmd", "cmd/app", "cmd/app/app.go", "cmd/app/app_test.go", "main.go",
+	}, walkPaths(t, dst, WalkOptions{}))
+
+	err = CopyDirectory(filepath.Join(root, "main.go"), dst)
+	assert.Error(t, err)
+	_, err = os.Stat(filepath.Join(dst, "vendor"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,97 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// FileInfo is the metadata of a file returned by Info, fields that are
+// unavailable on the current platform are left empty (or -1 for ids)
+type FileInfo struct {
+	Path         string      `json:"path"`
+	Name         string      `json:"name"`
+	Size         int64       `json:"size"`
+	Mode         os.FileMode `json:"mode"`
+	Permissions  string      `json:"permissions"`
+	IsDir        bool        `json:"is_dir"`
+	IsSymlink    bool        `json:"is_symlink"`
+	LinkTarget   string      `json:"link_target,omitempty"`
+	UID          int         `json:"uid"`
+	GID          int         `json:"gid"`
+	Owner        string      `json:"owner,omitempty"`
+	Group        string      `json:"group,omitempty"`
+	ModTime      time.Time   `json:"mod_time"`
+	AccessTime   *time.Time  `json:"access_time,omitempty"`
+	ChangeTime   *time.Time  `json:"change_time,omitempty"`
+	CreationTime *time.Time  `json:"creation_time,omitempty"`
+}
+
+// Info simply returns the metadata of a file, directory or symlink (without following it),
+// including ownership and access, change and creation times where the platform provides them
+func Info(path string) (FileInfo, error) {
+
+	// Check IF Path Exists
+	info, err := os.Lstat(path)
+	if err != nil {
+		return FileInfo{}, fmt.Errorf("Path '%v' doesn't exist", path)
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return FileInfo{}, err
+	}
+
+	fileInfo := FileInfo{
+		Path:        abs,
+		Name:        info.Name(),
+		Size:        info.Size(),
+		Mode:        info.Mode(),
+		Permissions: info.Mode().String(),
+		IsDir:       info.IsDir(),
+		IsSymlink:   info.Mode()&os.ModeSymlink != 0,
+		UID:         -1,
+		GID:         -1,
+		ModTime:     info.ModTime(),
+	}
+
+	// Read Symlink Target
+	if fileInfo.IsSymlink {
+		fileInfo.LinkTarget, err = os.Readlink(path)
+		if err != nil {
+			return FileInfo{}, err
+		}
+	}
+
+	// Add Platform Metadata
+	platformInfo(path, info, &fileInfo)
+
+	// Look Up Owner and Group Names
+	if fileInfo.UID >= 0 {
+		if u, err := user.LookupId(strconv.Itoa(fileInfo.UID)); err == nil {
+			fileInfo.Owner = u.Username
+		}
+	}
+	if fileInfo.GID >= 0 {
+		if g, err := user.LookupGroupId(strconv.Itoa(fileInfo.GID)); err == nil {
+			fileInfo.Group = g.Name
+		}
+	}
+
+	return fileInfo, nil
+}
+
+// timePointer returns a pointer to a time for the optional FileInfo fields
+func timePointer(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,29 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build darwin
+// +build darwin
+
+package fs
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// platformInfo adds ownership and times (including the birth time) from stat(2)
+func platformInfo(path string, info os.FileInfo, fileInfo *FileInfo) {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		fileInfo.UID = int(stat.Uid)
+		fileInfo.GID = int(stat.Gid)
+		fileInfo.AccessTime = timePointer(time.Unix(stat.Atimespec.Unix()))
+		fileInfo.ChangeTime = timePointer(time.Unix(stat.Ctimespec.Unix()))
+		fileInfo.CreationTime = timePointer(time.Unix(stat.Birthtimespec.Unix()))
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build linux
+// +build linux
+
+package fs
+
+import (
+	"os"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// platformInfo adds ownership and times from stat(2), and the creation time from
+// statx(2) on filesystems that record it
+func platformInfo(path string, info os.FileInfo, fileInfo *FileInfo) {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		fileInfo.UID = int(stat.Uid)
+		fileInfo.GID = int(stat.Gid)
+		fileInfo.AccessTime = timePointer(time.Unix(stat.Atim.Unix()))
+		fileInfo.ChangeTime = timePointer(time.Unix(stat.Ctim.Unix()))
+	}
+
+	var statx unix.Statx_t
+	err := unix.Statx(unix.AT_FDCWD, path, unix.AT_SYMLINK_NOFOLLOW, unix.STATX_BTIME, &statx)
+	if err == nil && statx.Mask&unix.STATX_BTIME != 0 {
+		fileInfo.CreationTime = timePointer(time.Unix(statx.Btime.Sec, int64(statx.Btime.Nsec)))
+	}
+}
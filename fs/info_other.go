@@ -0,0 +1,20 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !linux && !darwin && !windows
+// +build !linux,!darwin,!windows
+
+package fs
+
+import (
+	"os"
+)
+
+// platformInfo has no additional metadata on this platform
+func platformInfo(path string, info os.FileInfo, fileInfo *FileInfo) {
+}
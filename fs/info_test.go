@@ -0,0 +1,49 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestInfo is a unit test for fs.Info()
+func TestInfo(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.txt")
+	assert.NoError(t, OverwriteFile(path, 0640, []byte("test")))
+
+	info, err := Info(path)
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, "test.txt", info.Name)
+	assert.Equal(t, int64(4), info.Size)
+	assert.False(t, info.IsDir)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, "-rw-r-----", info.Permissions)
+		assert.Equal(t, os.Getuid(), info.UID)
+	}
+
+	data, err := json.Marshal(info)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"name":"test.txt"`)
+
+	link := filepath.Join(dir, "link.txt")
+	if os.Symlink("test.txt", link) == nil {
+		info, err = Info(link)
+		assert.NoError(t, err)
+		assert.True(t, info.IsSymlink)
+		assert.Equal(t, "test.txt", info.LinkTarget)
+	}
+}
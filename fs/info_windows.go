@@ -0,0 +1,26 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build windows
+// +build windows
+
+package fs
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// platformInfo adds the access and creation times, Windows has no uid/gid ownership
+func platformInfo(path string, info os.FileInfo, fileInfo *FileInfo) {
+	if data, ok := info.Sys().(*syscall.Win32FileAttributeData); ok {
+		fileInfo.AccessTime = timePointer(time.Unix(0, data.LastAccessTime.Nanoseconds()))
+		fileInfo.CreationTime = timePointer(time.Unix(0, data.CreationTime.Nanoseconds()))
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	iofs "io/fs"
+	"path"
+	"strings"
+)
+
+// ReadFileFS simply reads a file from an io/fs.FS (e.g. embed.FS, os.DirFS or
+// zip.Reader), using the slash-separated paths of io/fs
+func ReadFileFS(fsys iofs.FS, name string) ([]byte, error) {
+
+	// Check IF File Exists
+	info, err := iofs.Stat(fsys, name)
+	if err != nil {
+		return nil, fmt.Errorf("File '%v' doesn't exist", name)
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("File '%v' is a directory", name)
+	}
+
+	// Read File
+	return iofs.ReadFile(fsys, name)
+}
+
+// WalkFS walks the file tree of an io/fs.FS rooted at root (e.g. "."), calling fn
+// with the slash-separated path of each file and directory that passes the
+// filters in WalkOptions. FollowSymlinks and Concurrency are not used by WalkFS.
+func WalkFS(fsys iofs.FS, root string, options WalkOptions, fn WalkFunc) error {
+
+	w := &walker{
+		options: options,
+		include: NewIgnoreMatcher(options.Include),
+		exclude: NewIgnoreMatcher(options.Exclude),
+	}
+
+	return iofs.WalkDir(fsys, root, func(name string, entry iofs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if name == root {
+			return fn(name, info)
+		}
+
+		rel := strings.TrimPrefix(name, root+"/")
+		if root == "." {
+			rel = name
+		}
+		depth := strings.Count(rel, "/") + 1
+
+		// Apply Filters
+		skip := (options.SkipHidden && strings.HasPrefix(path.Base(name), ".")) ||
+			(options.MaxDepth > 0 && depth > options.MaxDepth) ||
+			w.skip(rel, entry.IsDir())
+		if skip {
+			if entry.IsDir() {
+				return iofs.SkipDir
+			}
+			return nil
+		}
+
+		return fn(name, info)
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// jsonOptions configure WriteJSON
+type jsonOptions struct {
+	pretty bool
+}
+
+// JSONOption configures WriteJSON
+type JSONOption func(*jsonOptions)
+
+// JSONPretty indents the JSON written by WriteJSON with two spaces (default true)
+func JSONPretty(pretty bool) JSONOption {
+	return func(o *jsonOptions) {
+		o.pretty = pretty
+	}
+}
+
+// ReadJSON simply reads a JSON file and unmarshals it into v
+func ReadJSON(path string, v interface{}) error {
+
+	// Read File
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("File '%v' doesn't exist", path)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to read file '%v': %w", path, err)
+	}
+
+	// Decode JSON
+	err = json.Unmarshal(data, v)
+	if err != nil {
+		return fmt.Errorf("File '%v' is not valid JSON: %v", path, err)
+	}
+
+	return nil
+}
+
+// WriteJSON marshals v as JSON and atomically writes it to a file (replacing
+// any existing file), pretty printed unless JSONPretty(false) is set
+func WriteJSON(path string, v interface{}, mode os.FileMode, opts ...JSONOption) error {
+
+	// Apply JSON Options
+	options := jsonOptions{pretty: true}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// Encode JSON
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if options.pretty {
+		encoder.SetIndent("", "  ")
+	}
+	err := encoder.Encode(v)
+	if err != nil {
+		return err
+	}
+
+	// Write File
+	return WriteFileAtomic(path, mode, buf.Bytes())
+}
@@ -0,0 +1,416 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WriteFS is an io/fs.FS that files, directories and symbolic links can be written to
+// using the slash-separated paths of io/fs (e.g. MemFS)
+type WriteFS interface {
+	iofs.FS
+
+	// MkdirAll creates a directory and any missing parents
+	MkdirAll(name string, perm os.FileMode) error
+
+	// Create creates or truncates a file, its contents are written when the writer is closed
+	Create(name string, perm os.FileMode) (io.WriteCloser, error)
+
+	// Symlink creates a symbolic link to target
+	Symlink(target string, name string) error
+
+	// Lstat returns the FileInfo of a file without following a symbolic link
+	Lstat(name string) (iofs.FileInfo, error)
+
+	// Remove removes a file, symbolic link or empty directory
+	Remove(name string) error
+
+	// Chmod sets the permissions of a file or directory
+	Chmod(name string, mode os.FileMode) error
+
+	// Chtimes sets the access and modification times of a file or directory
+	Chtimes(name string, atime time.Time, mtime time.Time) error
+}
+
+// maxLinkDepth limits the symbolic links followed when opening a file in a MemFS
+const maxLinkDepth = 40
+
+// MemFS is an in-memory filesystem implementing io/fs.FS (with ReadDir, ReadFile and
+// Stat) and WriteFS, e.g. to extract or generate files in tests and pipelines without
+// temporary directories. Symbolic links are followed when they are the last element
+// of a path. A MemFS is safe for concurrent use.
+type MemFS struct {
+	mutex sync.RWMutex
+	files map[string]*memFile
+}
+
+// memFile is a file, directory or symbolic link in a MemFS
+type memFile struct {
+	data     []byte
+	mode     os.FileMode
+	modified time.Time
+	link     string
+}
+
+// NewMemFS simply creates an empty MemFS
+func NewMemFS() *MemFS {
+	return &MemFS{files: map[string]*memFile{
+		".": {mode: os.ModeDir | 0755, modified: time.Now()},
+	}}
+}
+
+// Open opens a file or directory for reading (io/fs.FS)
+func (m *MemFS) Open(name string) (iofs.File, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	name, file, err := m.resolve("open", name)
+	if err != nil {
+		return nil, err
+	}
+	info := memInfo{name: path.Base(name), file: file}
+	if file.mode.IsDir() {
+		return &memDir{info: info, entries: m.children(name)}, nil
+	}
+	return &memReader{info: info, reader: bytes.NewReader(file.data)}, nil
+}
+
+// ReadFile returns the contents of a file (io/fs.ReadFileFS)
+func (m *MemFS) ReadFile(name string) ([]byte, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	_, file, err := m.resolve("read", name)
+	if err != nil {
+		return nil, err
+	}
+	if file.mode.IsDir() {
+		return nil, &iofs.PathError{Op: "read", Path: name, Err: errors.New("is a directory")}
+	}
+	return append([]byte(nil), file.data...), nil
+}
+
+// ReadDir returns the entries of a directory sorted by name (io/fs.ReadDirFS)
+func (m *MemFS) ReadDir(name string) ([]iofs.DirEntry, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	name, file, err := m.resolve("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	if !file.mode.IsDir() {
+		return nil, &iofs.PathError{Op: "readdir", Path: name, Err: errors.New("not a directory")}
+	}
+	return m.children(name), nil
+}
+
+// Stat returns the FileInfo of a file, following a symbolic link (io/fs.StatFS)
+func (m *MemFS) Stat(name string) (iofs.FileInfo, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	resolved, file, err := m.resolve("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return memInfo{name: path.Base(resolved), file: file}, nil
+}
+
+// Lstat returns the FileInfo of a file without following a symbolic link
+func (m *MemFS) Lstat(name string) (iofs.FileInfo, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	file, err := m.lookup("lstat", name)
+	if err != nil {
+		return nil, err
+	}
+	return memInfo{name: path.Base(name), file: file}, nil
+}
+
+// ReadLink returns the target of a symbolic link
+func (m *MemFS) ReadLink(name string) (string, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	file, err := m.lookup("readlink", name)
+	if err != nil {
+		return "", err
+	}
+	if file.mode&os.ModeSymlink == 0 {
+		return "", &iofs.PathError{Op: "readlink", Path: name, Err: iofs.ErrInvalid}
+	}
+	return file.link, nil
+}
+
+// MkdirAll creates a directory and any missing parents
+func (m *MemFS) MkdirAll(name string, perm os.FileMode) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !iofs.ValidPath(name) {
+		return &iofs.PathError{Op: "mkdir", Path: name, Err: iofs.ErrInvalid}
+	}
+
+	// Create each Missing Directory
+	dir := ""
+	for _, element := range strings.Split(name, "/") {
+		dir = path.Join(dir, element)
+		file, ok := m.files[dir]
+		switch {
+		case !ok:
+			m.files[dir] = &memFile{mode: os.ModeDir | perm.Perm(), modified: time.Now()}
+		case !file.mode.IsDir():
+			return &iofs.PathError{Op: "mkdir", Path: dir, Err: errors.New("not a directory")}
+		}
+	}
+	return nil
+}
+
+// WriteFile writes the contents of a file, creating or truncating it
+func (m *MemFS) WriteFile(name string, data []byte, perm os.FileMode) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	err := m.checkParent("write", name)
+	if err != nil {
+		return err
+	}
+	if file, ok := m.files[name]; ok && file.mode.IsDir() {
+		return &iofs.PathError{Op: "write", Path: name, Err: errors.New("is a directory")}
+	}
+	m.files[name] = &memFile{data: append([]byte(nil), data...), mode: perm.Perm(), modified: time.Now()}
+	return nil
+}
+
+// Create creates or truncates a file, its contents are written when the writer is closed
+func (m *MemFS) Create(name string, perm os.FileMode) (io.WriteCloser, error) {
+	m.mutex.RLock()
+	err := m.checkParent("create", name)
+	m.mutex.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return &memWriter{fs: m, name: name, perm: perm}, nil
+}
+
+// Symlink creates a symbolic link to target
+func (m *MemFS) Symlink(target string, name string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	err := m.checkParent("symlink", name)
+	if err != nil {
+		return err
+	}
+	if _, ok := m.files[name]; ok {
+		return &iofs.PathError{Op: "symlink", Path: name, Err: iofs.ErrExist}
+	}
+	m.files[name] = &memFile{mode: os.ModeSymlink | 0777, modified: time.Now(), link: target}
+	return nil
+}
+
+// Remove removes a file, symbolic link or empty directory
+func (m *MemFS) Remove(name string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	file, err := m.lookup("remove", name)
+	if err != nil {
+		return err
+	}
+	if name == "." || (file.mode.IsDir() && len(m.children(name)) > 0) {
+		return &iofs.PathError{Op: "remove", Path: name, Err: errors.New("directory not empty")}
+	}
+	delete(m.files, name)
+	return nil
+}
+
+// Chmod sets the permissions of a file or directory
+func (m *MemFS) Chmod(name string, mode os.FileMode) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	file, err := m.lookup("chmod", name)
+	if err != nil {
+		return err
+	}
+	file.mode = file.mode.Type() | mode.Perm()
+	return nil
+}
+
+// Chtimes sets the modification time of a file or directory (a MemFS has no access times)
+func (m *MemFS) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	file, err := m.lookup("chtimes", name)
+	if err != nil {
+		return err
+	}
+	file.modified = mtime
+	return nil
+}
+
+// lookup returns a file without following a symbolic link
+func (m *MemFS) lookup(op string, name string) (*memFile, error) {
+	if !iofs.ValidPath(name) {
+		return nil, &iofs.PathError{Op: op, Path: name, Err: iofs.ErrInvalid}
+	}
+	file, ok := m.files[name]
+	if !ok {
+		return nil, &iofs.PathError{Op: op, Path: name, Err: iofs.ErrNotExist}
+	}
+	return file, nil
+}
+
+// resolve returns a file, following symbolic links that are the last element of the path
+func (m *MemFS) resolve(op string, name string) (string, *memFile, error) {
+	original := name
+	for depth := 0; depth < maxLinkDepth; depth++ {
+		file, err := m.lookup(op, name)
+		if err != nil {
+			return "", nil, &iofs.PathError{Op: op, Path: original, Err: err.(*iofs.PathError).Err}
+		}
+		if file.mode&os.ModeSymlink == 0 {
+			return name, file, nil
+		}
+		name = path.Join(path.Dir(name), file.link)
+	}
+	return "", nil, &iofs.PathError{Op: op, Path: original, Err: errors.New("too many levels of symbolic links")}
+}
+
+// checkParent checks that the parent directory of a path exists
+func (m *MemFS) checkParent(op string, name string) error {
+	if !iofs.ValidPath(name) || name == "." {
+		return &iofs.PathError{Op: op, Path: name, Err: iofs.ErrInvalid}
+	}
+	parent, ok := m.files[path.Dir(name)]
+	if !ok {
+		return &iofs.PathError{Op: op, Path: name, Err: iofs.ErrNotExist}
+	}
+	if !parent.mode.IsDir() {
+		return &iofs.PathError{Op: op, Path: name, Err: errors.New("not a directory")}
+	}
+	return nil
+}
+
+// children returns the entries of a directory sorted by name
+func (m *MemFS) children(dir string) []iofs.DirEntry {
+	prefix := dir + "/"
+	if dir == "." {
+		prefix = ""
+	}
+	var entries []iofs.DirEntry
+	for name, file := range m.files {
+		if name == "." || !strings.HasPrefix(name, prefix) || strings.Contains(name[len(prefix):], "/") {
+			continue
+		}
+		entries = append(entries, memInfo{name: path.Base(name), file: file})
+	}
+	sort.Slice(entries, func(i int, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries
+}
+
+// memInfo is the io/fs.FileInfo and io/fs.DirEntry of a file in a MemFS
+type memInfo struct {
+	name string
+	file *memFile
+}
+
+func (info memInfo) Name() string                 { return info.name }
+func (info memInfo) Size() int64                  { return int64(len(info.file.data)) }
+func (info memInfo) Mode() os.FileMode            { return info.file.mode }
+func (info memInfo) ModTime() time.Time           { return info.file.modified }
+func (info memInfo) IsDir() bool                  { return info.file.mode.IsDir() }
+func (info memInfo) Sys() interface{}             { return nil }
+func (info memInfo) Type() os.FileMode            { return info.file.mode.Type() }
+func (info memInfo) Info() (iofs.FileInfo, error) { return info, nil }
+
+// memReader is a file of a MemFS opened for reading
+type memReader struct {
+	info   memInfo
+	reader *bytes.Reader
+}
+
+func (f *memReader) Stat() (iofs.FileInfo, error) { return f.info, nil }
+func (f *memReader) Read(p []byte) (int, error)   { return f.reader.Read(p) }
+func (f *memReader) Close() error                 { return nil }
+
+// ReadAt reads from an offset of the file (io.ReaderAt)
+func (f *memReader) ReadAt(p []byte, offset int64) (int, error) { return f.reader.ReadAt(p, offset) }
+
+// Seek sets the offset of the next Read (io.Seeker)
+func (f *memReader) Seek(offset int64, whence int) (int64, error) {
+	return f.reader.Seek(offset, whence)
+}
+
+// memDir is a directory of a MemFS opened for reading
+type memDir struct {
+	info    memInfo
+	entries []iofs.DirEntry
+	offset  int
+}
+
+func (d *memDir) Stat() (iofs.FileInfo, error) { return d.info, nil }
+func (d *memDir) Close() error                 { return nil }
+
+// Read fails for directories
+func (d *memDir) Read(p []byte) (int, error) {
+	return 0, &iofs.PathError{Op: "read", Path: d.info.name, Err: errors.New("is a directory")}
+}
+
+// ReadDir returns the next n entries of the directory, or all remaining entries when n <= 0
+// (io/fs.ReadDirFile)
+func (d *memDir) ReadDir(n int) ([]iofs.DirEntry, error) {
+	remaining := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return remaining, nil
+	}
+	if len(remaining) == 0 {
+		return nil, io.EOF
+	}
+	if n > len(remaining) {
+		n = len(remaining)
+	}
+	d.offset += n
+	return remaining[:n], nil
+}
+
+// memWriter buffers the contents of a file created in a MemFS until it is closed
+type memWriter struct {
+	fs     *MemFS
+	name   string
+	perm   os.FileMode
+	buffer bytes.Buffer
+	closed bool
+}
+
+func (w *memWriter) Write(p []byte) (int, error) { return w.buffer.Write(p) }
+
+// Close writes the contents to the MemFS
+func (w *memWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	return w.fs.WriteFile(w.name, w.buffer.Bytes(), w.perm)
+}
@@ -0,0 +1,82 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"io"
+	iofs "io/fs"
+	"os"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMemFS is a unit test for fs.MemFS
+func TestMemFS(t *testing.T) {
+	memfs := NewMemFS()
+	var _ WriteFS = memfs
+
+	// Write Files and Directories
+	assert.NoError(t, memfs.MkdirAll("docs/api", 0755))
+	assert.NoError(t, memfs.WriteFile("docs/README.md", []byte("readme"), 0644))
+	writer, err := memfs.Create("docs/api/index.html", 0600)
+	assert.NoError(t, err)
+	_, err = io.WriteString(writer, "<html>")
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+	assert.NoError(t, memfs.Symlink("README.md", "docs/link"))
+
+	// Assert io/fs Behaviour
+	assert.NoError(t, fstest.TestFS(memfs, "docs/README.md", "docs/api/index.html"))
+
+	// Assert Unit Test
+	data, err := iofs.ReadFile(memfs, "docs/api/index.html")
+	assert.NoError(t, err)
+	assert.Equal(t, "<html>", string(data))
+
+	data, err = memfs.ReadFile("docs/link")
+	assert.NoError(t, err)
+	assert.Equal(t, "readme", string(data))
+
+	info, err := memfs.Lstat("docs/link")
+	assert.NoError(t, err)
+	assert.Equal(t, os.ModeSymlink, info.Mode().Type())
+	link, err := memfs.ReadLink("docs/link")
+	assert.NoError(t, err)
+	assert.Equal(t, "README.md", link)
+
+	modified := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, memfs.Chmod("docs/api/index.html", 0644))
+	assert.NoError(t, memfs.Chtimes("docs/api/index.html", modified, modified))
+	info, err = memfs.Stat("docs/api/index.html")
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0644), info.Mode())
+	assert.True(t, modified.Equal(info.ModTime()))
+
+	entries, err := memfs.ReadDir("docs")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+	assert.Equal(t, "README.md", entries[0].Name())
+
+	// Assert Errors
+	_, err = memfs.Open("missing.txt")
+	assert.ErrorIs(t, err, iofs.ErrNotExist)
+	_, err = memfs.Create("missing/file.txt", 0644)
+	assert.ErrorIs(t, err, iofs.ErrNotExist)
+	assert.ErrorIs(t, memfs.Symlink("README.md", "docs/link"), iofs.ErrExist)
+	assert.Error(t, memfs.MkdirAll("docs/README.md/sub", 0755))
+	assert.Error(t, memfs.Remove("docs"))
+	assert.ErrorIs(t, memfs.WriteFile("../escape.txt", nil, 0644), iofs.ErrInvalid)
+
+	assert.NoError(t, memfs.Remove("docs/link"))
+	_, err = memfs.Lstat("docs/link")
+	assert.ErrorIs(t, err, iofs.ErrNotExist)
+}
@@ -0,0 +1,90 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// contentTypes maps common file extensions to MIME types, so detection doesn't
+// depend on the MIME database of the operating system
+var contentTypes = map[string]string{
+	".7z":   "application/x-7z-compressed",
+	".bz2":  "application/x-bzip2",
+	".css":  "text/css; charset=utf-8",
+	".csv":  "text/csv; charset=utf-8",
+	".gz":   "application/gzip",
+	".go":   "text/x-go; charset=utf-8",
+	".htm":  "text/html; charset=utf-8",
+	".html": "text/html; charset=utf-8",
+	".js":   "text/javascript; charset=utf-8",
+	".json": "application/json",
+	".md":   "text/markdown; charset=utf-8",
+	".rar":  "application/vnd.rar",
+	".sh":   "text/x-shellscript; charset=utf-8",
+	".svg":  "image/svg+xml",
+	".tar":  "application/x-tar",
+	".tgz":  "application/gzip",
+	".toml": "application/toml",
+	".txt":  "text/plain; charset=utf-8",
+	".xml":  "text/xml; charset=utf-8",
+	".xz":   "application/x-xz",
+	".yaml": "application/yaml",
+	".yml":  "application/yaml",
+	".zip":  "application/zip",
+	".zst":  "application/zstd",
+}
+
+// DetectContentType simply returns the MIME type of a file (e.g. "image/png"),
+// sniffing the first 512 bytes of content and using the file extension when
+// the content alone is ambiguous (e.g. plain text that is JSON or YAML)
+func DetectContentType(path string) (string, error) {
+
+	// Check IF File Exists
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("File '%v' doesn't exist", path)
+	}
+	defer file.Close()
+
+	// Read First 512 Bytes
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	// Sniff File Content
+	sniffed := http.DetectContentType(buf[:n])
+	generic := sniffed == "application/octet-stream" || strings.HasPrefix(sniffed, "text/plain")
+	if !generic {
+		return sniffed, nil
+	}
+
+	// Map File Extension
+	extension := strings.ToLower(filepath.Ext(path))
+	if contentType, ok := contentTypes[extension]; ok {
+		// Binary Content doesn't match a Text Extension
+		if sniffed == "application/octet-stream" && strings.HasPrefix(contentType, "text/") {
+			return sniffed, nil
+		}
+		return contentType, nil
+	}
+	if contentType := mime.TypeByExtension(extension); contentType != "" && extension != "" {
+		return contentType, nil
+	}
+
+	return sniffed, nil
+}
@@ -0,0 +1,41 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDetectContentType is a unit test for fs.DetectContentType()
+func TestDetectContentType(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name        string
+		data        []byte
+		contentType string
+	}{
+		{"image.dat", []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR"), "image/png"},
+		{"config.json", []byte(`{"name": "gogo"}`), "application/json"},
+		{"config.yaml", []byte("name: gogo\n"), "application/yaml"},
+		{"notes", []byte("hello world\n"), "text/plain; charset=utf-8"},
+		{"archive.zip", []byte("PK\x03\x04"), "application/zip"},
+	}
+	for _, test := range tests {
+		path := filepath.Join(dir, test.name)
+		assert.NoError(t, OverwriteFile(path, 0644, test.data))
+
+		contentType, err := DetectContentType(path)
+		// Assert Unit Test
+		assert.NoError(t, err)
+		assert.Equal(t, test.contentType, contentType, test.name)
+	}
+}
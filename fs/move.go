@@ -0,0 +1,137 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// rename renames the source of Move (a variable so unit tests can fake a move across
+// filesystems)
+var rename = os.Rename
+
+// Move simply moves (renames) a file or directory after verifying the source
+// exists and the destination does *not* exist.
+//
+// When the source and destination are on the same filesystem, Move is an
+// atomic os.Rename. When they are on different filesystems (EXDEV), Move
+// falls back to copying the source to a temporary path next to the destination,
+// renaming it into place and then deleting the source. The destination
+// therefore never appears partially written, but the move as a whole is *not*
+// atomic: a crash may leave both the source and the destination in place.
+func Move(src string, dst string) error {
+
+	// Check IF Source Exists
+	_, err := os.Lstat(src)
+	if err != nil {
+		return fmt.Errorf("Source '%v' doesn't exist", src)
+	}
+
+	// Check IF Destination Exists
+	_, err = os.Lstat(dst)
+	if err == nil {
+		return fmt.Errorf("Destination '%v' already exists", dst)
+	}
+
+	// Rename Source
+	err = rename(src, dst)
+	if err == nil || !isCrossDevice(err) {
+		return err
+	}
+
+	// Copy Source to a Temporary Path next to the Destination
+	tmp := filepath.Join(filepath.Dir(dst), fmt.Sprintf(".%v.move-%v", filepath.Base(dst), os.Getpid()))
+	err = copyTree(src, tmp)
+	if err != nil {
+		os.RemoveAll(tmp)
+		return err
+	}
+
+	// Rename Temporary Path into place
+	err = os.Rename(tmp, dst)
+	if err != nil {
+		os.RemoveAll(tmp)
+		return err
+	}
+
+	// Delete Source
+	return os.RemoveAll(src)
+}
+
+// copyTree recursively copies a file, symbolic link or directory
+// preserving file modes and modification times
+func copyTree(src string, dst string, opts ...CopyOption) error {
+
+	// Apply Copy Options
+	options := copyOptions{preserveTimes: true}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// Directories are Writable until their Contents are Copied
+	var dirs []copiedDir
+
+	err := Walk(src, WalkOptions{Ignore: options.ignore}, func(path string, info os.FileInfo) error {
+
+		// Set Destination Path
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case info.IsDir():
+			// Create Directory
+			dirs = append(dirs, copiedDir{path: target, mode: info.Mode().Perm(), modified: info.ModTime()})
+			return os.MkdirAll(target, info.Mode().Perm()|0700)
+		case info.Mode()&os.ModeSymlink != 0:
+			// Create Symbolic Link
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case info.Mode().IsRegular():
+			// Copy File
+			return CopyFile(path, target, opts...)
+		}
+
+		return fmt.Errorf("File '%v' is not a regular file, directory or symbolic link", path)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Set Directory Permissions and Modification Times (deepest first)
+	for i := len(dirs) - 1; i >= 0; i-- {
+		err = os.Chmod(dirs[i].path, dirs[i].mode)
+		if err != nil {
+			return err
+		}
+		if options.preserveTimes {
+			err = os.Chtimes(dirs[i].path, dirs[i].modified, dirs[i].modified)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// copiedDir is a directory created by copyTree
+type copiedDir struct {
+	path     string
+	mode     os.FileMode
+	modified time.Time
+}
@@ -0,0 +1,134 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeMoveTree writes the source tree of the Move unit tests
+func writeMoveTree(t *testing.T, dir string) {
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0755))
+	assert.NoError(t, OverwriteFile(filepath.Join(dir, "a.txt"), 0644, []byte("alpha")))
+	assert.NoError(t, OverwriteFile(filepath.Join(dir, "sub", "run.sh"), 0755, []byte("#!/bin/sh")))
+	modified := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, os.Chtimes(filepath.Join(dir, "sub"), modified, modified))
+}
+
+// assertMoveTree asserts the tree written by writeMoveTree was moved to dir
+func assertMoveTree(t *testing.T, dir string) {
+	data, err := os.ReadFile(filepath.Join(dir, "a.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "alpha", string(data))
+	info, err := os.Stat(filepath.Join(dir, "sub", "run.sh"))
+	assert.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+	}
+}
+
+// TestMove is a unit test for fs.Move()
+func TestMove(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	writeMoveTree(t, src)
+
+	// Assert Unit Test
+	file := filepath.Join(dir, "moved.txt")
+	assert.NoError(t, Move(filepath.Join(src, "a.txt"), file))
+	assert.NoFileExists(t, filepath.Join(src, "a.txt"))
+	assert.FileExists(t, file)
+	assert.NoError(t, Move(file, filepath.Join(src, "a.txt")))
+
+	// Assert Directory Trees are Moved
+	dst := filepath.Join(dir, "dst")
+	assert.NoError(t, Move(src, dst))
+	assert.NoDirExists(t, src)
+	assertMoveTree(t, dst)
+
+	// Assert Invalid Moves are Rejected
+	assert.ErrorContains(t, Move(src, filepath.Join(dir, "other")), "doesn't exist")
+	assert.NoError(t, OverwriteFile(src, 0644, []byte("src")))
+	assert.ErrorContains(t, Move(src, dst), "already exists")
+	assert.FileExists(t, src)
+}
+
+// TestMoveCrossDevice is a unit test for fs.Move() copying and deleting the source across filesystems
+func TestMoveCrossDevice(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test fakes EXDEV")
+	}
+	defer func() { rename = os.Rename }()
+	rename = func(oldpath string, newpath string) error {
+		return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: syscall.EXDEV}
+	}
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	writeMoveTree(t, src)
+	assert.NoError(t, os.Symlink("a.txt", filepath.Join(src, "link")))
+
+	// Assert Unit Test
+	dst := filepath.Join(dir, "dst")
+	assert.NoError(t, Move(src, dst))
+	assert.NoDirExists(t, src)
+	assertMoveTree(t, dst)
+	modified := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	info, err := os.Stat(filepath.Join(dst, "sub"))
+	assert.NoError(t, err)
+	assert.True(t, modified.Equal(info.ModTime()), info.ModTime())
+	link, err := os.Readlink(filepath.Join(dst, "link"))
+	assert.NoError(t, err)
+	assert.Equal(t, "a.txt", link)
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// Assert a Failed Copy keeps the Source and leaves no Temporary Path
+	file := filepath.Join(dst, "a.txt")
+	assert.Error(t, Move(file, filepath.Join(dir, "missing", "a.txt")))
+	assert.FileExists(t, file)
+	assert.NoDirExists(t, filepath.Join(dir, "missing"))
+	entries, err = os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+// TestCopyTree is a unit test for fs.copyTree() copying read-only directories
+func TestCopyTree(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("directory permissions are not supported")
+	}
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	dst := filepath.Join(dir, "dst")
+	assert.NoError(t, os.MkdirAll(filepath.Join(src, "ro"), 0755))
+	assert.NoError(t, OverwriteFile(filepath.Join(src, "ro", "f.txt"), 0644, []byte("alpha")))
+	modified := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, os.Chtimes(filepath.Join(src, "ro"), modified, modified))
+	assert.NoError(t, os.Chmod(filepath.Join(src, "ro"), 0555))
+	defer os.Chmod(filepath.Join(src, "ro"), 0755)
+	defer os.Chmod(filepath.Join(dst, "ro"), 0755)
+
+	// Assert Unit Test
+	assert.NoError(t, copyTree(src, dst))
+	data, err := os.ReadFile(filepath.Join(dst, "ro", "f.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "alpha", string(data))
+	info, err := os.Stat(filepath.Join(dst, "ro"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0555), info.Mode().Perm())
+	assert.True(t, modified.Equal(info.ModTime()), info.ModTime())
+}
@@ -0,0 +1,98 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// RelativeTo simply returns the path of target relative to base as a slash-separated
+// path (e.g. "../docs/README.md"), making both paths absolute if only one of them is
+func RelativeTo(base string, target string) (string, error) {
+
+	// Make Paths Comparable
+	base, target, err := comparablePaths(base, target)
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(base, target)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.ToSlash(rel), nil
+}
+
+// CommonPrefix returns the longest slash-separated directory path shared by all
+// paths (e.g. "/src/app" for "/src/app/main.go" and "/src/app/cmd"), or "" if
+// the paths have nothing in common
+func CommonPrefix(paths ...string) string {
+	if len(paths) == 0 {
+		return ""
+	}
+
+	// Split First Path into Segments
+	prefix := strings.Split(path.Clean(filepath.ToSlash(paths[0])), "/")
+
+	// Shorten Prefix to the Segments shared with each Path
+	for _, p := range paths[1:] {
+		segments := strings.Split(path.Clean(filepath.ToSlash(p)), "/")
+		n := 0
+		for n < len(prefix) && n < len(segments) && sameSegment(prefix[n], segments[n]) {
+			n++
+		}
+		prefix = prefix[:n]
+	}
+
+	switch {
+	case len(prefix) == 0:
+		return ""
+	case len(prefix) == 1 && prefix[0] == "":
+		return "/"
+	}
+	return strings.Join(prefix, "/")
+}
+
+// IsSubPath simply checks if child is parent or a path inside parent, comparing
+// cleaned paths (case-insensitively on Windows) without touching the filesystem
+func IsSubPath(parent string, child string) bool {
+	rel, err := RelativeTo(parent, child)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, "../")
+}
+
+// comparablePaths cleans two paths, making both absolute if only one of them is
+// (the case is kept, filepath.Rel already compares case-insensitively on Windows)
+func comparablePaths(a string, b string) (string, string, error) {
+	a, b = filepath.Clean(filepath.FromSlash(a)), filepath.Clean(filepath.FromSlash(b))
+	if filepath.IsAbs(a) != filepath.IsAbs(b) {
+		var err error
+		if a, err = filepath.Abs(a); err != nil {
+			return "", "", err
+		}
+		if b, err = filepath.Abs(b); err != nil {
+			return "", "", err
+		}
+	}
+	return a, b, nil
+}
+
+// sameSegment compares path segments (case-insensitively on Windows)
+func sameSegment(a string, b string) bool {
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
@@ -0,0 +1,75 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRelativeTo is a unit test for fs.RelativeTo()
+func TestRelativeTo(t *testing.T) {
+	rel, err := RelativeTo("project/src", "project/docs/README.md")
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, "../docs/README.md", rel)
+
+	rel, err = RelativeTo("project", "project")
+	assert.NoError(t, err)
+	assert.Equal(t, ".", rel)
+
+	// Assert the Case of the Target is Kept
+	rel, err = RelativeTo("Project", "Project/Sub/File.TXT")
+	assert.NoError(t, err)
+	assert.Equal(t, "Sub/File.TXT", rel)
+	if runtime.GOOS == "windows" {
+		rel, err = RelativeTo(`C:\Base`, `c:\base\Sub\File.TXT`)
+		assert.NoError(t, err)
+		assert.Equal(t, "Sub/File.TXT", rel)
+		assert.True(t, IsSubPath(`C:\Base`, `c:\BASE\Sub`))
+		assert.Equal(t, "C:/Base", CommonPrefix(`C:\Base\a`, `c:\base\b`))
+	}
+}
+
+// TestCommonPrefix is a unit test for fs.CommonPrefix()
+func TestCommonPrefix(t *testing.T) {
+	// Assert Unit Test
+	assert.Equal(t, "/src/app", CommonPrefix("/src/app/main.go", "/src/app/cmd/", "/src/app"))
+	assert.Equal(t, "/", CommonPrefix("/src", "/var"))
+	assert.Equal(t, "src", CommonPrefix("src/a", "src/b"))
+	assert.Equal(t, "", CommonPrefix("src", "docs"))
+	assert.Equal(t, "", CommonPrefix())
+}
+
+// TestIsSubPath is a unit test for fs.IsSubPath()
+func TestIsSubPath(t *testing.T) {
+	// Assert Unit Test
+	assert.True(t, IsSubPath("project", "project/src/main.go"))
+	assert.True(t, IsSubPath("project", "project"))
+	assert.False(t, IsSubPath("project", "project-old/main.go"))
+	assert.False(t, IsSubPath("project/src", "project"))
+}
+
+// TestFindUp is a unit test for fs.FindUp()
+func TestFindUp(t *testing.T) {
+	root := t.TempDir()
+	assert.NoError(t, TouchAll(filepath.Join(root, "go.mod")))
+	assert.NoError(t, TouchAll(filepath.Join(root, "cmd", "app", "main.go")))
+
+	dir, err := FindUp(filepath.Join(root, "cmd", "app", "main.go"), ".git", "go.mod")
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, root, dir)
+
+	_, err = FindUp(filepath.Join(root, "cmd"), "gogo-missing-marker")
+	assert.Error(t, err)
+}
@@ -0,0 +1,54 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// SanitizePath cleans an untrusted relative path (e.g. an archive entry name or a
+// user supplied file name) and joins it to base, returning an error if the path is
+// absolute or would escape base using ../ components. Both / and \ are treated
+// as separators so paths crafted for another platform are rejected as well.
+func SanitizePath(base string, untrusted string) (string, error) {
+
+	// Check for Invalid Characters
+	if strings.ContainsRune(untrusted, 0) {
+		return "", fmt.Errorf("Path '%v' contains a NUL character", untrusted)
+	}
+
+	// Check for Absolute Paths
+	name := strings.ReplaceAll(untrusted, `\`, "/")
+	if strings.HasPrefix(name, "/") || filepath.IsAbs(untrusted) || filepath.VolumeName(untrusted) != "" || hasDriveLetter(name) {
+		return "", fmt.Errorf("Path '%v' is absolute", untrusted)
+	}
+
+	// Check for Traversal outside Base
+	name = path.Clean(name)
+	if name == ".." || strings.HasPrefix(name, "../") {
+		return "", fmt.Errorf("Path '%v' escapes '%v'", untrusted, base)
+	}
+	if name == "." {
+		return filepath.Clean(base), nil
+	}
+
+	return filepath.Join(base, filepath.FromSlash(name)), nil
+}
+
+// hasDriveLetter reports whether a slash-separated path starts with a Windows drive letter (e.g. "C:")
+func hasDriveLetter(name string) bool {
+	if len(name) < 2 || name[1] != ':' {
+		return false
+	}
+	c := name[0]
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
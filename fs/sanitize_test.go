@@ -0,0 +1,43 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSanitizePath is a unit test for fs.SanitizePath()
+func TestSanitizePath(t *testing.T) {
+	base := filepath.Join("tmp", "extract")
+
+	// Assert Unit Test
+	for untrusted, expected := range map[string]string{
+		"file.txt":             filepath.Join(base, "file.txt"),
+		"dir/./sub/../file":    filepath.Join(base, "dir", "file"),
+		`dir\file.txt`:         filepath.Join(base, "dir", "file.txt"),
+		"dir/../../extract/ok": "",
+		".":                    base,
+	} {
+		path, err := SanitizePath(base, untrusted)
+		if expected == "" {
+			assert.Error(t, err, untrusted)
+			continue
+		}
+		assert.NoError(t, err, untrusted)
+		assert.Equal(t, expected, path, untrusted)
+	}
+
+	for _, untrusted := range []string{"../etc/passwd", "a/../../b", "/etc/passwd", `\windows\system32`, "C:/windows", `..\..\evil`, "a\x00b"} {
+		_, err := SanitizePath(base, untrusted)
+		assert.Error(t, err, untrusted)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"os"
+)
+
+// SecureDelete overwrites the contents of a file with random data the given number
+// of passes (at least one), flushing each pass to disk, before deleting the file.
+// Note that journaling and copy-on-write filesystems or SSD wear leveling may
+// still retain copies of the original data.
+func SecureDelete(path string, passes int) error {
+
+	// Check IF File Exists
+	info, err := os.Lstat(path)
+	if err != nil {
+		return fmt.Errorf("File '%v' doesn't exist", path)
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("File '%v' is not a regular file", path)
+	}
+	if passes < 1 {
+		passes = 1
+	}
+
+	// Open File
+	file, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite File Contents
+	for pass := 0; pass < passes; pass++ {
+		err = overwriteRandom(file, info.Size())
+		if err != nil {
+			file.Close()
+			return err
+		}
+	}
+
+	// Truncate File
+	err = file.Truncate(0)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	// Delete File
+	return os.Remove(path)
+}
+
+// overwriteRandom writes size bytes of random data from the start of a file and syncs it
+func overwriteRandom(file *os.File, size int64) error {
+	_, err := file.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	_, err = io.CopyN(file, rand.Reader, size)
+	if err != nil {
+		return err
+	}
+	return file.Sync()
+}
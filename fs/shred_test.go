@@ -0,0 +1,80 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSecureDelete is a unit test for fs.SecureDelete()
+func TestSecureDelete(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "secret.key")
+	secret := bytes.Repeat([]byte("secret"), 1000)
+
+	for _, passes := range []int{0, 3} {
+		assert.NoError(t, OverwriteFile(path, 0600, secret))
+
+		// Assert Unit Test
+		assert.NoError(t, SecureDelete(path, passes))
+		assert.NoFileExists(t, path)
+	}
+
+	// Assert the Contents are Destroyed (seen through a hard link)
+	assert.NoError(t, OverwriteFile(path, 0600, secret))
+	link := filepath.Join(dir, "link.key")
+	if os.Link(path, link) == nil {
+		assert.NoError(t, SecureDelete(path, 1))
+		assert.NoFileExists(t, path)
+		data, err := os.ReadFile(link)
+		assert.NoError(t, err)
+		assert.Empty(t, data)
+	}
+}
+
+// TestOverwriteRandom is a unit test for fs.overwriteRandom()
+func TestOverwriteRandom(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secret.key")
+	secret := bytes.Repeat([]byte("secret"), 1000)
+	assert.NoError(t, OverwriteFile(path, 0600, secret))
+	file, err := os.OpenFile(path, os.O_WRONLY, 0)
+	assert.NoError(t, err)
+
+	// Assert Unit Test
+	assert.NoError(t, overwriteRandom(file, int64(len(secret))))
+	assert.NoError(t, file.Close())
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Len(t, data, len(secret))
+	assert.False(t, bytes.Contains(data, []byte("secret")))
+}
+
+// TestSecureDeleteErrors is a unit test for fs.SecureDelete() rejecting paths that are not regular files
+func TestSecureDeleteErrors(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "secret.key")
+	assert.NoError(t, OverwriteFile(path, 0600, []byte("secret")))
+
+	// Assert Unit Test
+	assert.ErrorContains(t, SecureDelete(filepath.Join(dir, "missing.key"), 1), "doesn't exist")
+	assert.ErrorContains(t, SecureDelete(dir, 1), "is not a regular file")
+	assert.DirExists(t, dir)
+	link := filepath.Join(dir, "link.key")
+	if os.Symlink(path, link) == nil {
+		assert.ErrorContains(t, SecureDelete(link, 1), "is not a regular file")
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, "secret", string(data))
+	}
+}
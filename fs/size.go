@@ -0,0 +1,72 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+)
+
+// DirSize simply returns the total size in bytes of the regular files in a directory
+// tree. Optional WalkOptions exclude paths (e.g. Exclude: []string{".git/"}) or
+// walk large trees concurrently.
+func DirSize(path string, options ...WalkOptions) (int64, error) {
+
+	// Check IF Directory Exists
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, fmt.Errorf("Directory '%v' doesn't exist", path)
+	}
+	if !info.IsDir() {
+		return 0, fmt.Errorf("Directory '%v' is not a directory", path)
+	}
+
+	walkOptions := WalkOptions{}
+	if len(options) > 0 {
+		walkOptions = options[0]
+	}
+
+	// Sum File Sizes
+	var size int64
+	err = Walk(path, walkOptions, func(path string, info os.FileInfo) error {
+		if info.Mode().IsRegular() {
+			atomic.AddInt64(&size, info.Size())
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// DirSizeHuman returns the total size of a directory tree formatted for display (e.g. "1.5 MiB")
+func DirSizeHuman(path string, options ...WalkOptions) (string, error) {
+	size, err := DirSize(path, options...)
+	if err != nil {
+		return "", err
+	}
+	return HumanSize(size), nil
+}
+
+// HumanSize formats a size in bytes using binary units (e.g. "512 B", "1.5 MiB")
+func HumanSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
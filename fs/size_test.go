@@ -0,0 +1,23 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHumanSize is a unit test for fs.HumanSize()
+func TestHumanSize(t *testing.T) {
+	// Assert Unit Test
+	assert.Equal(t, "512 B", HumanSize(512))
+	assert.Equal(t, "1.0 KiB", HumanSize(1024))
+	assert.Equal(t, "1.5 MiB", HumanSize(1536*1024))
+}
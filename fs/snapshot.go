@@ -0,0 +1,130 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ManifestEntry describes a single path in a Manifest
+type ManifestEntry struct {
+	Path string      `json:"path"`           // slash-separated path relative to the root
+	Size int64       `json:"size"`           // size in bytes (regular files)
+	Mode os.FileMode `json:"mode"`           // file mode and permissions
+	Hash string      `json:"hash,omitempty"` // SHA256 checksum (regular files)
+	Link string      `json:"link,omitempty"` // target (symlinks)
+}
+
+// Manifest is a snapshot of a directory tree sorted by path, which can be saved
+// with WriteJSON and compared with DiffSnapshots
+type Manifest []ManifestEntry
+
+// SnapshotDiff reports the paths that changed between two Manifests
+type SnapshotDiff struct {
+	Added    []string `json:"added"`
+	Removed  []string `json:"removed"`
+	Modified []string `json:"modified"`
+}
+
+// Empty reports whether the Manifests compared were identical
+func (diff SnapshotDiff) Empty() bool {
+	return len(diff.Added) == 0 && len(diff.Removed) == 0 && len(diff.Modified) == 0
+}
+
+// Snapshot returns a Manifest recording the path, size, mode and SHA256 checksum
+// of every file and directory below root. Optional WalkOptions filter the paths
+// recorded.
+func Snapshot(root string, options ...WalkOptions) (Manifest, error) {
+
+	// Check IF Directory Exists
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("Directory '%v' doesn't exist", root)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("Directory '%v' is not a directory", root)
+	}
+
+	walkOptions := WalkOptions{}
+	if len(options) > 0 {
+		walkOptions = options[0]
+		walkOptions.Concurrency = 0
+	}
+
+	// Record Entries
+	manifest := Manifest{}
+	err = Walk(root, walkOptions, func(path string, info os.FileInfo) error {
+		if path == root {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		entry := ManifestEntry{Path: filepath.ToSlash(rel), Mode: info.Mode()}
+		switch {
+		case info.Mode().IsRegular():
+			entry.Size = info.Size()
+			entry.Hash, err = Checksum(path, SHA256)
+		case info.Mode()&os.ModeSymlink != 0:
+			entry.Link, err = os.Readlink(path)
+		}
+		if err != nil {
+			return err
+		}
+
+		manifest = append(manifest, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(manifest, func(i, j int) bool {
+		return manifest[i].Path < manifest[j].Path
+	})
+	return manifest, nil
+}
+
+// DiffSnapshots compares two Manifests of a directory tree, reporting the paths
+// added in b, removed from a and modified (size, mode, contents or symlink target)
+func DiffSnapshots(a Manifest, b Manifest) SnapshotDiff {
+	diff := SnapshotDiff{Added: []string{}, Removed: []string{}, Modified: []string{}}
+
+	entries := map[string]ManifestEntry{}
+	for _, entry := range a {
+		entries[entry.Path] = entry
+	}
+
+	// Find Added and Modified Paths
+	for _, entry := range b {
+		previous, ok := entries[entry.Path]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, entry.Path)
+		case previous != entry:
+			diff.Modified = append(diff.Modified, entry.Path)
+		}
+		delete(entries, entry.Path)
+	}
+
+	// Find Removed Paths
+	for path := range entries {
+		diff.Removed = append(diff.Removed, path)
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Modified)
+	return diff
+}
@@ -0,0 +1,43 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSnapshot is a unit test for fs.Snapshot() and fs.DiffSnapshots()
+func TestSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, OverwriteFile(filepath.Join(dir, "changed.txt"), 0644, []byte("before")))
+	assert.NoError(t, TouchAll(filepath.Join(dir, "removed", "file.txt")))
+	assert.NoError(t, TouchAll(filepath.Join(dir, "same.txt")))
+
+	before, err := Snapshot(dir)
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Len(t, before, 4)
+
+	assert.NoError(t, OverwriteFile(filepath.Join(dir, "changed.txt"), 0644, []byte("after!")))
+	assert.NoError(t, os.RemoveAll(filepath.Join(dir, "removed")))
+	assert.NoError(t, TouchAll(filepath.Join(dir, "added.txt")))
+
+	after, err := Snapshot(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, SnapshotDiff{
+		Added:    []string{"added.txt"},
+		Removed:  []string{"removed", "removed/file.txt"},
+		Modified: []string{"changed.txt"},
+	}, DiffSnapshots(before, after))
+	assert.True(t, DiffSnapshots(after, after).Empty())
+}
@@ -0,0 +1,107 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"io"
+	"os"
+)
+
+// sparseBlockSize is the size of the zero blocks CopySparse leaves as holes
+const sparseBlockSize = 4096
+
+// CopySparse copies a stream into a file, seeking over blocks of zeros instead of
+// writing them so they become holes on filesystems supporting sparse files (e.g. when
+// extracting VM images or database files from an archive). The file is truncated to
+// the end of the copied data and the number of bytes copied is returned.
+func CopySparse(dst *os.File, src io.Reader) (int64, error) {
+	buf := make([]byte, 8*sparseBlockSize)
+	var written int64
+
+	for {
+		n, readErr := io.ReadFull(src, buf)
+
+		// Write Data Blocks and Skip Zero Blocks
+		for start := 0; start < n; start += sparseBlockSize {
+			end := start + sparseBlockSize
+			if end > n {
+				end = n
+			}
+			block := buf[start:end]
+			var err error
+			if isZero(block) {
+				_, err = dst.Seek(int64(len(block)), io.SeekCurrent)
+			} else {
+				_, err = dst.Write(block)
+			}
+			if err != nil {
+				return written, err
+			}
+			written += int64(len(block))
+		}
+
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return written, readErr
+		}
+	}
+
+	// Set File Size (trailing holes aren't written)
+	offset, err := dst.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return written, err
+	}
+	return written, dst.Truncate(offset)
+}
+
+// isZero reports whether a block contains only zero bytes
+func isZero(block []byte) bool {
+	for _, b := range block {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// copyRanges copies the data ranges of a sparse source file, leaving holes between them
+// (the holes are reported as written when reporting copy progress)
+func copyRanges(destination *os.File, source *os.File, writer io.Writer, ranges [][2]int64, size int64) error {
+	var offset int64
+	for _, r := range ranges {
+		skipHole(writer, r[0]-offset)
+		_, err := source.Seek(r[0], io.SeekStart)
+		if err != nil {
+			return err
+		}
+		_, err = destination.Seek(r[0], io.SeekStart)
+		if err != nil {
+			return err
+		}
+		_, err = io.CopyN(writer, source, r[1]-r[0])
+		if err != nil {
+			return err
+		}
+		offset = r[1]
+	}
+	skipHole(writer, size-offset)
+
+	// Set File Size (trailing holes aren't written)
+	return destination.Truncate(size)
+}
+
+// skipHole reports the bytes of a hole that is not written to a progressWriter
+func skipHole(writer io.Writer, n int64) {
+	if w, ok := writer.(*progressWriter); ok && n > 0 {
+		w.written += n
+		w.progress(w.written, w.total)
+	}
+}
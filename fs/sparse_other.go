@@ -0,0 +1,21 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !linux && !darwin && !freebsd
+// +build !linux,!darwin,!freebsd
+
+package fs
+
+import (
+	"os"
+)
+
+// dataRanges can't detect holes on this platform, so files are copied normally
+func dataRanges(file *os.File, size int64) ([][2]int64, bool) {
+	return nil, false
+}
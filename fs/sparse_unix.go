@@ -0,0 +1,57 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build linux || darwin || freebsd
+// +build linux darwin freebsd
+
+package fs
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// dataRanges returns the [start, end) ranges of a file holding data using
+// SEEK_DATA/SEEK_HOLE, or false if the file has no holes or the filesystem
+// can't report them
+func dataRanges(file *os.File, size int64) ([][2]int64, bool) {
+	if size == 0 {
+		return nil, false
+	}
+	defer file.Seek(0, io.SeekStart)
+
+	var ranges [][2]int64
+	for offset := int64(0); offset < size; {
+		data, err := file.Seek(offset, unix.SEEK_DATA)
+		if errors.Is(err, unix.ENXIO) {
+			break
+		}
+		if err != nil {
+			return nil, false
+		}
+		hole, err := file.Seek(data, unix.SEEK_HOLE)
+		if err != nil {
+			return nil, false
+		}
+		if hole > size {
+			hole = size
+		}
+		ranges = append(ranges, [2]int64{data, hole})
+		offset = hole
+	}
+
+	// Copy Files without Holes Normally
+	if len(ranges) == 1 && ranges[0] == [2]int64{0, size} {
+		return nil, false
+	}
+
+	return ranges, true
+}
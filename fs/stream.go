@@ -0,0 +1,73 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// ReadFileChunks simply reads a file in chunks of up to chunkSize bytes, calling fn
+// for each chunk so large files can be processed with bounded memory. The chunk
+// buffer is reused between calls, so fn must copy any data it keeps.
+func ReadFileChunks(path string, chunkSize int, fn func(chunk []byte) error) error {
+
+	if chunkSize <= 0 {
+		return fmt.Errorf("Chunk size '%v' must be greater than zero", chunkSize)
+	}
+
+	// Check IF File Exists
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("File '%v' doesn't exist", path)
+	}
+	defer file.Close()
+
+	// Read File Chunks
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := io.ReadFull(file, buf)
+		if n > 0 {
+			if fnErr := fn(buf[:n]); fnErr != nil {
+				return fnErr
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// CopyWithProgress copies a file like CopyFile, calling progress with the bytes
+// written so far and the total size of the source file as the copy proceeds
+func CopyWithProgress(src string, dst string, progress func(written int64, total int64), opts ...CopyOption) error {
+	return CopyFile(src, dst, append(opts, CopyProgress(progress))...)
+}
+
+// progressWriter reports the bytes written through it
+type progressWriter struct {
+	writer   io.Writer
+	written  int64
+	total    int64
+	progress func(written int64, total int64)
+}
+
+// Write writes to the underlying writer and reports progress
+func (w *progressWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.written += int64(n)
+	if n > 0 {
+		w.progress(w.written, w.total)
+	}
+	return n, err
+}
@@ -0,0 +1,110 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestReadFileChunks is a unit test for fs.ReadFileChunks()
+func TestReadFileChunks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.txt")
+	assert.NoError(t, OverwriteFile(path, 0644, []byte("abcdefghij")))
+
+	chunks := []string{}
+	err := ReadFileChunks(path, 4, func(chunk []byte) error {
+		chunks = append(chunks, string(chunk))
+		return nil
+	})
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"abcd", "efgh", "ij"}, chunks)
+}
+
+// TestCopyWithProgress is a unit test for fs.CopyWithProgress()
+func TestCopyWithProgress(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.bin")
+	dst := filepath.Join(dir, "dst.bin")
+	assert.NoError(t, OverwriteFile(src, 0644, bytes.Repeat([]byte("x"), 100000)))
+
+	var written, total int64
+	err := CopyWithProgress(src, dst, func(w int64, t int64) {
+		written, total = w, t
+	})
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100000), written)
+	assert.Equal(t, int64(100000), total)
+
+	equal, err := FilesEqual(src, dst)
+	assert.NoError(t, err)
+	assert.True(t, equal)
+}
+
+// TestClone is a unit test for fs.Clone()
+func TestClone(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.bin")
+	dst := filepath.Join(dir, "dst.bin")
+	assert.NoError(t, OverwriteFile(src, 0600, bytes.Repeat([]byte("x"), 10000)))
+
+	// Assert Unit Test
+	assert.NoError(t, Clone(src, dst))
+	equal, err := FilesEqual(src, dst)
+	assert.NoError(t, err)
+	assert.True(t, equal)
+	assert.Error(t, Clone(src, dst))
+}
+
+// TestCopySparse is a unit test for fs.CopyFile() and fs.CopySparse() with sparse files
+func TestCopySparse(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "sparse.img")
+	file, err := os.Create(src)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Truncate(4<<20))
+	_, err = file.WriteAt([]byte("data"), 1<<20)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	// Assert Unit Test
+	dst := filepath.Join(dir, "copy.img")
+	assert.NoError(t, CopyFile(src, dst))
+	equal, err := FilesEqual(src, dst)
+	assert.NoError(t, err)
+	assert.True(t, equal)
+
+	// Assert Progress reaches the Total (holes count as copied)
+	var progress, total int64
+	assert.NoError(t, CopyFile(src, filepath.Join(dir, "progress.img"), CopyProgress(func(w int64, t int64) {
+		progress, total = w, t
+	})))
+	assert.Equal(t, int64(4<<20), progress)
+	assert.Equal(t, int64(4<<20), total)
+
+	extracted := filepath.Join(dir, "extracted.img")
+	out, err := os.Create(extracted)
+	assert.NoError(t, err)
+	in, err := os.Open(src)
+	assert.NoError(t, err)
+	written, err := CopySparse(out, in)
+	in.Close()
+	out.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4<<20), written)
+	equal, err = FilesEqual(src, extracted)
+	assert.NoError(t, err)
+	assert.True(t, equal)
+}
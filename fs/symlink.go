@@ -0,0 +1,68 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// IsSymlink simply checks if a path is a symbolic link (without following it)
+func IsSymlink(path string) bool {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeSymlink != 0
+}
+
+// ReadSymlink returns the target of a symbolic link as stored in the link
+// (which may be relative to the directory containing the link)
+func ReadSymlink(path string) (string, error) {
+
+	// Check IF Symlink Exists
+	info, err := os.Lstat(path)
+	if err != nil {
+		return "", fmt.Errorf("Symlink '%v' doesn't exist", path)
+	}
+	if info.Mode()&os.ModeSymlink == 0 {
+		return "", fmt.Errorf("Path '%v' is not a symlink", path)
+	}
+
+	return os.Readlink(path)
+}
+
+// ResolveSymlinks returns the absolute path of a file after following every
+// symbolic link in the path, reporting broken links and symlink loops
+func ResolveSymlinks(path string) (string, error) {
+
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		switch {
+		case errors.Is(err, syscall.ELOOP) || isLoopError(err):
+			return "", fmt.Errorf("Path '%v' contains a symlink loop", path)
+		case os.IsNotExist(err):
+			if IsSymlink(path) {
+				return "", fmt.Errorf("Symlink '%v' is broken", path)
+			}
+			return "", fmt.Errorf("Path '%v' doesn't exist", path)
+		}
+		return "", err
+	}
+
+	return filepath.Abs(resolved)
+}
+
+// isLoopError reports whether filepath.EvalSymlinks gave up following links
+func isLoopError(err error) bool {
+	return err != nil && err.Error() == "EvalSymlinks: too many links"
+}
@@ -0,0 +1,49 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSymlinks is a unit test for fs.IsSymlink(), fs.ReadSymlink() and fs.ResolveSymlinks()
+func TestSymlinks(t *testing.T) {
+	dir, _ := filepath.EvalSymlinks(t.TempDir())
+	target := filepath.Join(dir, "target.txt")
+	link := filepath.Join(dir, "link.txt")
+	assert.NoError(t, Touch(target))
+	if err := os.Symlink("target.txt", link); err != nil {
+		t.Skip("symlinks are not supported")
+	}
+
+	// Assert Unit Test
+	assert.True(t, IsSymlink(link))
+	assert.False(t, IsSymlink(target))
+
+	destination, err := ReadSymlink(link)
+	assert.NoError(t, err)
+	assert.Equal(t, "target.txt", destination)
+
+	resolved, err := ResolveSymlinks(link)
+	assert.NoError(t, err)
+	assert.Equal(t, target, resolved)
+
+	assert.NoError(t, os.Symlink("missing.txt", filepath.Join(dir, "broken.txt")))
+	_, err = ResolveSymlinks(filepath.Join(dir, "broken.txt"))
+	assert.EqualError(t, err, "Symlink '"+filepath.Join(dir, "broken.txt")+"' is broken")
+
+	assert.NoError(t, os.Symlink("loop-b", filepath.Join(dir, "loop-a")))
+	assert.NoError(t, os.Symlink("loop-a", filepath.Join(dir, "loop-b")))
+	_, err = ResolveSymlinks(filepath.Join(dir, "loop-a"))
+	assert.EqualError(t, err, "Path '"+filepath.Join(dir, "loop-a")+"' contains a symlink loop")
+}
@@ -0,0 +1,241 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// SyncAction identifies an operation planned by Sync
+type SyncAction string
+
+// Sync Actions
+const (
+	SyncMkdir   SyncAction = "mkdir"   // create a directory
+	SyncCopy    SyncAction = "copy"    // copy a new file
+	SyncUpdate  SyncAction = "update"  // replace a changed file
+	SyncSymlink SyncAction = "symlink" // create or replace a symlink
+	SyncDelete  SyncAction = "delete"  // delete an extraneous file or directory
+)
+
+// SyncOperation is a single operation planned (and performed) by Sync
+type SyncOperation struct {
+	Action SyncAction
+	Path   string // slash-separated path relative to src and dst
+}
+
+// String returns the operation as "<action> <path>" (e.g. "copy docs/README.md")
+func (op SyncOperation) String() string {
+	return fmt.Sprintf("%v %v", op.Action, op.Path)
+}
+
+// SyncOptions configure Sync
+type SyncOptions struct {
+	// Checksum compares file contents instead of size and modification time
+	Checksum bool
+
+	// Delete removes files and directories in dst that don't exist in src
+	Delete bool
+
+	// DryRun returns the planned operations without changing dst
+	DryRun bool
+
+	// Exclude skips paths in src and dst matching .gitignore-style rules
+	Exclude []string
+
+	// Ignore skips paths in src and dst matched by an IgnoreMatcher
+	Ignore *IgnoreMatcher
+}
+
+// Sync makes dst a copy of the directory src, like rsync: new and changed files are
+// copied (compared by size and modification time unless SyncOptions.Checksum is
+// set), preserving modes and modification times. Sync returns the operations
+// performed, or only planned when SyncOptions.DryRun is set.
+func Sync(src string, dst string, options SyncOptions) ([]SyncOperation, error) {
+
+	// Check IF Source Directory Exists
+	info, err := os.Stat(src)
+	if err != nil {
+		return nil, fmt.Errorf("Source '%v' doesn't exist", src)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("Source '%v' is not a directory", src)
+	}
+
+	// Plan Operations
+	plan, err := planSync(src, dst, options)
+	if err != nil {
+		return nil, err
+	}
+	if options.DryRun {
+		return plan, nil
+	}
+
+	// Perform Operations
+	for i, op := range plan {
+		err = performSync(src, dst, op)
+		if err != nil {
+			return plan[:i], err
+		}
+	}
+
+	return plan, nil
+}
+
+// planSync compares src and dst and returns the operations making dst a copy of src
+func planSync(src string, dst string, options SyncOptions) ([]SyncOperation, error) {
+	plan := []SyncOperation{}
+	walkOptions := WalkOptions{Exclude: options.Exclude, Ignore: options.Ignore}
+
+	// Create Destination Directory
+	if _, err := os.Lstat(dst); err != nil {
+		plan = append(plan, SyncOperation{SyncMkdir, "."})
+	}
+
+	// Compare Source Paths with Destination
+	var deletes []SyncOperation
+	sources := map[string]bool{}
+	err := Walk(src, walkOptions, func(path string, info os.FileInfo) error {
+		if path == src {
+			return nil
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		sources[rel] = true
+
+		target, err := os.Lstat(filepath.Join(dst, filepath.FromSlash(rel)))
+		exists := err == nil
+
+		// Replace Paths of a Different Type
+		if exists && target.Mode().Type() != info.Mode().Type() {
+			plan = append(plan, SyncOperation{SyncDelete, rel})
+			exists = false
+		}
+
+		switch {
+		case info.IsDir():
+			if !exists {
+				plan = append(plan, SyncOperation{SyncMkdir, rel})
+			}
+		case info.Mode()&os.ModeSymlink != 0:
+			if !exists || !sameSymlink(path, filepath.Join(dst, filepath.FromSlash(rel))) {
+				plan = append(plan, SyncOperation{SyncSymlink, rel})
+			}
+		case info.Mode().IsRegular():
+			if !exists {
+				plan = append(plan, SyncOperation{SyncCopy, rel})
+				break
+			}
+			changed, err := fileChanged(path, info, filepath.Join(dst, filepath.FromSlash(rel)), target, options.Checksum)
+			if err != nil {
+				return err
+			}
+			if changed {
+				plan = append(plan, SyncOperation{SyncUpdate, rel})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Find Extraneous Destination Paths
+	if options.Delete {
+		if _, err := os.Lstat(dst); err == nil {
+			err = Walk(dst, walkOptions, func(path string, info os.FileInfo) error {
+				if path == dst {
+					return nil
+				}
+				rel, err := filepath.Rel(dst, path)
+				if err != nil {
+					return err
+				}
+				rel = filepath.ToSlash(rel)
+				if !sources[rel] {
+					deletes = append(deletes, SyncOperation{SyncDelete, rel})
+					if info.IsDir() {
+						return filepath.SkipDir
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Delete Extraneous Paths First
+	sort.SliceStable(deletes, func(i, j int) bool {
+		return strings.Count(deletes[i].Path, "/") > strings.Count(deletes[j].Path, "/")
+	})
+
+	return append(deletes, plan...), nil
+}
+
+// performSync performs a single planned operation
+func performSync(src string, dst string, op SyncOperation) error {
+	source := filepath.Join(src, filepath.FromSlash(op.Path))
+	target := filepath.Join(dst, filepath.FromSlash(op.Path))
+
+	switch op.Action {
+	case SyncMkdir:
+		info, err := os.Stat(source)
+		if err != nil {
+			return err
+		}
+		return os.MkdirAll(target, info.Mode().Perm())
+	case SyncCopy, SyncUpdate:
+		return CopyFile(source, target, CopyOverwrite(true))
+	case SyncSymlink:
+		link, err := os.Readlink(source)
+		if err != nil {
+			return err
+		}
+		os.Remove(target)
+		return os.Symlink(link, target)
+	case SyncDelete:
+		return os.RemoveAll(target)
+	}
+
+	return fmt.Errorf("Sync action '%v' is not supported", op.Action)
+}
+
+// fileChanged reports whether a destination file differs from its source file
+func fileChanged(src string, srcInfo os.FileInfo, dst string, dstInfo os.FileInfo, checksum bool) (bool, error) {
+	if srcInfo.Size() != dstInfo.Size() {
+		return true, nil
+	}
+	if !checksum {
+		return !srcInfo.ModTime().Equal(dstInfo.ModTime()), nil
+	}
+	equal, err := FilesEqual(src, dst)
+	return !equal, err
+}
+
+// sameSymlink reports whether two symlinks have the same target
+func sameSymlink(a string, b string) bool {
+	targetA, err := os.Readlink(a)
+	if err != nil {
+		return false
+	}
+	targetB, err := os.Readlink(b)
+	if err != nil {
+		return false
+	}
+	return targetA == targetB
+}
@@ -0,0 +1,51 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSync is a unit test for fs.Sync()
+func TestSync(t *testing.T) {
+	src, dst := t.TempDir(), t.TempDir()
+	assert.NoError(t, EnsureDirectory(filepath.Join(src, "docs"), 0755))
+	assert.NoError(t, OverwriteFile(filepath.Join(src, "docs", "README.md"), 0644, []byte("readme")))
+	assert.NoError(t, OverwriteFile(filepath.Join(src, "main.go"), 0644, []byte("package main")))
+	assert.NoError(t, TouchAll(filepath.Join(src, "build", "out.bin")))
+	assert.NoError(t, OverwriteFile(filepath.Join(dst, "main.go"), 0644, []byte("old")))
+	assert.NoError(t, TouchAll(filepath.Join(dst, "stale", "old.txt")))
+	options := SyncOptions{Delete: true, DryRun: true, Exclude: []string{"build/"}}
+
+	plan, err := Sync(src, dst, options)
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, []SyncOperation{
+		{SyncDelete, "stale"},
+		{SyncMkdir, "docs"},
+		{SyncCopy, "docs/README.md"},
+		{SyncUpdate, "main.go"},
+	}, plan)
+	assert.NoFileExists(t, filepath.Join(dst, "docs", "README.md"))
+
+	options.DryRun = false
+	_, err = Sync(src, dst, options)
+	assert.NoError(t, err)
+	equal, differences, err := DirsEqual(src, dst)
+	assert.NoError(t, err)
+	assert.False(t, equal)
+	assert.Equal(t, []string{"build", "build/out.bin"}, differences)
+
+	plan, err = Sync(src, dst, options)
+	assert.NoError(t, err)
+	assert.Empty(t, plan)
+}
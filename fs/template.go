@@ -0,0 +1,39 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// WriteTemplate renders a text/template with data and atomically writes the result
+// to a file, replacing any existing file. Missing map keys in data are an error
+// rather than rendering "<no value>", and the file is left untouched if rendering fails.
+func WriteTemplate(path string, tmpl string, data interface{}, mode os.FileMode) error {
+
+	// Parse Template
+	t, err := template.New(filepath.Base(path)).Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("Template for File '%v' is not valid: %v", path, err)
+	}
+
+	// Render Template
+	var buf bytes.Buffer
+	err = t.Execute(&buf, data)
+	if err != nil {
+		return fmt.Errorf("Template for File '%v' failed to render: %v", path, err)
+	}
+
+	// Write File
+	return WriteFileAtomic(path, mode, buf.Bytes())
+}
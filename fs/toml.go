@@ -0,0 +1,53 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/BurntSushi/toml"
+)
+
+// ReadTOML simply reads a TOML file and unmarshals it into v
+func ReadTOML(path string, v interface{}) error {
+
+	// Read File
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("File '%v' doesn't exist", path)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to read file '%v': %w", path, err)
+	}
+
+	// Decode TOML
+	err = toml.Unmarshal(data, v)
+	if err != nil {
+		return fmt.Errorf("File '%v' is not valid TOML: %v", path, err)
+	}
+
+	return nil
+}
+
+// WriteTOML marshals v as TOML and atomically writes it to a file, replacing any existing file
+func WriteTOML(path string, v interface{}, mode os.FileMode) error {
+
+	// Encode TOML
+	var buf bytes.Buffer
+	err := toml.NewEncoder(&buf).Encode(v)
+	if err != nil {
+		return err
+	}
+
+	// Write File
+	return WriteFileAtomic(path, mode, buf.Bytes())
+}
@@ -0,0 +1,125 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Fallback Trash Directory used when the OS trash is unavailable
+var (
+	trashMutex     sync.Mutex
+	trashDirectory string
+)
+
+// SetTrashDirectory sets the directory Trash() moves paths to when the OS trash or
+// recycle bin is unavailable (default: a .trash directory next to the trashed path)
+func SetTrashDirectory(dir string) {
+	trashMutex.Lock()
+	defer trashMutex.Unlock()
+	trashDirectory = dir
+}
+
+// Trash simply moves a file or directory to the trash instead of deleting it: the
+// FreeDesktop.org trash on Linux and other Unix systems, ~/.Trash on macOS and the
+// Recycle Bin on Windows. If the OS trash is unavailable the path is moved to the
+// fallback trash directory (see SetTrashDirectory). Trash returns the new location
+// of the path, or "" when the Recycle Bin doesn't report it.
+func Trash(path string) (string, error) {
+
+	// Check IF Path Exists
+	_, err := os.Lstat(path)
+	if err != nil {
+		return "", fmt.Errorf("Path '%v' doesn't exist", path)
+	}
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	// Move Path to OS Trash
+	location, err := osTrash(path)
+	if err == nil {
+		return location, nil
+	}
+
+	// Move Path to Fallback Trash Directory
+	return fallbackTrash(path)
+}
+
+// fallbackTrash moves a path to the fallback trash directory
+func fallbackTrash(path string) (string, error) {
+	trashMutex.Lock()
+	dir := trashDirectory
+	trashMutex.Unlock()
+	if dir == "" {
+		dir = filepath.Join(filepath.Dir(path), ".trash")
+	}
+
+	err := EnsureDirectory(dir, 0700)
+	if err != nil {
+		return "", err
+	}
+
+	target := uniqueName(dir, filepath.Base(path))
+	err = Move(path, target)
+	if err != nil {
+		return "", err
+	}
+
+	return target, nil
+}
+
+// uniqueName returns a path in dir named name, or name.N if the name is taken
+func uniqueName(dir string, name string) string {
+	target := filepath.Join(dir, name)
+	for n := 2; ; n++ {
+		if _, err := os.Lstat(target); os.IsNotExist(err) {
+			return target
+		}
+		target = filepath.Join(dir, fmt.Sprintf("%v.%d", name, n))
+	}
+}
+
+// DeleteOlderThan deletes the regular files in a directory tree that were last
+// modified more than age ago (e.g. 30 * 24 * time.Hour), returning the deleted
+// paths. Directories are kept, even if they are left empty.
+func DeleteOlderThan(dir string, age time.Duration) ([]string, error) {
+
+	// Check IF Directory Exists
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("Directory '%v' doesn't exist", dir)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("Directory '%v' is not a directory", dir)
+	}
+
+	// Find Old Files
+	paths, err := Find(dir, Criteria{OlderThan: age, Type: TypeFile})
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete Old Files
+	deleted := []string{}
+	for _, path := range paths {
+		err = os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			return deleted, err
+		}
+		deleted = append(deleted, path)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,40 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build darwin
+// +build darwin
+
+package fs
+
+import (
+	"path/filepath"
+)
+
+// osTrash moves a path to the user's ~/.Trash directory
+func osTrash(path string) (string, error) {
+
+	// Find Trash Directory
+	home, err := HomeDirectory()
+	if err != nil {
+		return "", err
+	}
+	trash := filepath.Join(home, ".Trash")
+	err = EnsureDirectory(trash, 0700)
+	if err != nil {
+		return "", err
+	}
+
+	// Move Path to Trash
+	target := uniqueName(trash, filepath.Base(path))
+	err = Move(path, target)
+	if err != nil {
+		return "", err
+	}
+
+	return target, nil
+}
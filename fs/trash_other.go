@@ -0,0 +1,76 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !windows && !darwin
+// +build !windows,!darwin
+
+package fs
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// osTrash moves a path to the FreeDesktop.org home trash ($XDG_DATA_HOME/Trash),
+// writing a .trashinfo file so file managers can restore it
+func osTrash(path string) (string, error) {
+
+	// Find Home Trash Directory
+	trash, err := dataBase.path("Trash")
+	if err != nil {
+		return "", err
+	}
+	files := filepath.Join(trash, "files")
+	info := filepath.Join(trash, "info")
+	for _, dir := range []string{files, info} {
+		err = EnsureDirectory(dir, 0700)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// Reserve Trash Name with the .trashinfo File
+	name := filepath.Base(path)
+	var infoFile *os.File
+	for n := 1; ; n++ {
+		if n > 1 {
+			name = fmt.Sprintf("%v.%d", filepath.Base(path), n)
+		}
+		infoFile, err = os.OpenFile(filepath.Join(info, name+".trashinfo"), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err == nil {
+			break
+		}
+		if !os.IsExist(err) {
+			return "", err
+		}
+	}
+
+	// Write .trashinfo File
+	escaped := (&url.URL{Path: path}).EscapedPath()
+	_, err = fmt.Fprintf(infoFile, "[Trash Info]\nPath=%v\nDeletionDate=%v\n", escaped, time.Now().Format("2006-01-02T15:04:05"))
+	if closeErr := infoFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(infoFile.Name())
+		return "", err
+	}
+
+	// Move Path to Trash
+	target := filepath.Join(files, name)
+	err = Move(path, target)
+	if err != nil {
+		os.Remove(infoFile.Name())
+		return "", err
+	}
+
+	return target, nil
+}
@@ -0,0 +1,61 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTrash is a unit test for fs.Trash()
+func TestTrash(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "darwin" {
+		t.Skip("test uses the FreeDesktop.org trash")
+	}
+	dir := t.TempDir()
+	t.Setenv("XDG_DATA_HOME", filepath.Join(dir, "data"))
+	path := filepath.Join(dir, "notes.txt")
+
+	for i := 1; i <= 2; i++ {
+		assert.NoError(t, OverwriteFile(path, 0644, []byte("notes")))
+		location, err := Trash(path)
+		// Assert Unit Test
+		assert.NoError(t, err)
+		assert.NoFileExists(t, path)
+		assert.FileExists(t, location)
+	}
+
+	assert.FileExists(t, filepath.Join(dir, "data", "Trash", "files", "notes.txt.2"))
+	info, err := os.ReadFile(filepath.Join(dir, "data", "Trash", "info", "notes.txt.trashinfo"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(info), "Path="+filepath.ToSlash(path))
+}
+
+// TestDeleteOlderThan is a unit test for fs.DeleteOlderThan()
+func TestDeleteOlderThan(t *testing.T) {
+	dir := t.TempDir()
+	old := filepath.Join(dir, "logs", "old.log")
+	recent := filepath.Join(dir, "logs", "recent.log")
+	assert.NoError(t, TouchAll(old))
+	assert.NoError(t, TouchAll(recent))
+	lastWeek := time.Now().Add(-7 * 24 * time.Hour)
+	assert.NoError(t, os.Chtimes(old, lastWeek, lastWeek))
+
+	deleted, err := DeleteOlderThan(dir, 24*time.Hour)
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, []string{old}, deleted)
+	assert.NoFileExists(t, old)
+	assert.FileExists(t, recent)
+}
@@ -0,0 +1,82 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build windows
+// +build windows
+
+package fs
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// SHFileOperationW Constants
+const (
+	foDelete          = 0x0003
+	fofSilent         = 0x0004
+	fofNoConfirmation = 0x0010
+	fofAllowUndo      = 0x0040
+	fofNoErrorUI      = 0x0400
+	fofNoConfirmMkdir = 0x0200
+	recycleFlags      = fofSilent | fofNoConfirmation | fofAllowUndo | fofNoErrorUI | fofNoConfirmMkdir
+	pointerSize64     = 8
+)
+
+// shFileOpStruct is the SHFILEOPSTRUCTW structure (naturally aligned on 64-bit Windows)
+type shFileOpStruct struct {
+	hwnd                  uintptr
+	wFunc                 uint32
+	pFrom                 *uint16
+	pTo                   *uint16
+	fFlags                uint16
+	fAnyOperationsAborted int32
+	hNameMappings         uintptr
+	lpszProgressTitle     *uint16
+}
+
+// SHFileOperationW from shell32.dll
+var shFileOperation = windows.NewLazySystemDLL("shell32.dll").NewProc("SHFileOperationW")
+
+// osTrash moves a path to the Recycle Bin with SHFileOperationW, the new location
+// isn't reported by Windows so "" is returned
+func osTrash(path string) (string, error) {
+
+	// SHFILEOPSTRUCTW is packed on 32-bit Windows
+	if unsafe.Sizeof(uintptr(0)) != pointerSize64 {
+		return "", fmt.Errorf("Recycle Bin is not supported on 32-bit Windows")
+	}
+	if err := shFileOperation.Find(); err != nil {
+		return "", err
+	}
+
+	// Build Double NUL Terminated Path List
+	from, err := windows.UTF16FromString(path)
+	if err != nil {
+		return "", err
+	}
+	from = append(from, 0)
+
+	// Move Path to Recycle Bin
+	op := shFileOpStruct{
+		wFunc:  foDelete,
+		pFrom:  &from[0],
+		fFlags: recycleFlags,
+	}
+	result, _, _ := shFileOperation.Call(uintptr(unsafe.Pointer(&op)))
+	if result != 0 {
+		return "", fmt.Errorf("Path '%v' could not be moved to the Recycle Bin (error %#x)", path, result)
+	}
+	if op.fAnyOperationsAborted != 0 {
+		return "", fmt.Errorf("Path '%v' could not be moved to the Recycle Bin", path)
+	}
+
+	return "", nil
+}
@@ -0,0 +1,227 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// WalkOptions configure Walk
+type WalkOptions struct {
+	// Include limits the files passed to the WalkFunc to those matching one of
+	// the patterns (directories are always walked unless excluded)
+	Include []string
+
+	// Exclude skips files and directories matching .gitignore-style rules:
+	// patterns without a slash match the name at any depth, a leading slash
+	// anchors the pattern to the root, a trailing slash matches directories
+	// only and a leading ! re-includes paths excluded by an earlier rule
+	Exclude []string
+
+	// Ignore skips files and directories matched by an IgnoreMatcher (e.g. one
+	// loaded from a .gitignore file with LoadIgnoreFile), in addition to Exclude
+	Ignore *IgnoreMatcher
+
+	// MaxDepth limits how deep the walk descends below the root (0 is unlimited)
+	MaxDepth int
+
+	// SkipHidden skips files and directories whose name starts with a dot
+	SkipHidden bool
+
+	// FollowSymlinks walks into symlinked directories (each directory is visited once)
+	FollowSymlinks bool
+
+	// Concurrency is the number of goroutines walking directories in parallel,
+	// the WalkFunc is called concurrently and in no particular order when > 1
+	Concurrency int
+}
+
+// WalkFunc is called by Walk for each file and directory, returning
+// filepath.SkipDir for a directory skips its contents
+type WalkFunc func(path string, info os.FileInfo) error
+
+// Walk simply walks the file tree rooted at root, calling fn for each file and
+// directory (including root) that passes the filters in WalkOptions. Walk stops
+// at the first error returned by fn or encountered reading a directory.
+func Walk(root string, options WalkOptions, fn WalkFunc) error {
+
+	// Check IF Root Exists
+	info, err := os.Lstat(root)
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSymlink != 0 && options.FollowSymlinks {
+		info, err = os.Stat(root)
+		if err != nil {
+			return err
+		}
+	}
+
+	w := &walker{
+		options: options,
+		fn:      fn,
+		include: NewIgnoreMatcher(options.Include),
+		exclude: NewIgnoreMatcher(options.Exclude),
+		visited: map[string]bool{},
+	}
+	if options.Concurrency > 1 {
+		w.workers = make(chan struct{}, options.Concurrency-1)
+	}
+
+	// Walk Root
+	err = fn(root, info)
+	if err != nil || !info.IsDir() {
+		if err == filepath.SkipDir {
+			return nil
+		}
+		return err
+	}
+	w.walkDirectory(root, "", 0)
+	w.wait.Wait()
+
+	return w.err
+}
+
+// walker holds the state of a single Walk
+type walker struct {
+	options WalkOptions
+	fn      WalkFunc
+	include *IgnoreMatcher
+	exclude *IgnoreMatcher
+	workers chan struct{}
+	wait    sync.WaitGroup
+
+	mutex   sync.Mutex
+	visited map[string]bool
+	err     error
+}
+
+// fail records the first error of the walk
+func (w *walker) fail(err error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+// failed reports whether the walk has stopped with an error
+func (w *walker) failed() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.err != nil
+}
+
+// visit reports whether a directory is visited for the first time (when following symlinks)
+func (w *walker) visit(dir string) bool {
+	if !w.options.FollowSymlinks {
+		return true
+	}
+	real, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return false
+	}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.visited[real] {
+		return false
+	}
+	w.visited[real] = true
+	return true
+}
+
+// walkDirectory calls the WalkFunc for the entries of a directory and descends into
+// subdirectories, in a new goroutine when a worker is available
+func (w *walker) walkDirectory(dir string, rel string, depth int) {
+	if w.failed() || !w.visit(dir) {
+		return
+	}
+
+	// Read Directory Entries (sorted by name)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		w.fail(err)
+		return
+	}
+
+	for _, entry := range entries {
+		if w.failed() {
+			return
+		}
+
+		name := entry.Name()
+		entryPath := filepath.Join(dir, name)
+		entryRel := path.Join(rel, name)
+
+		// Skip Hidden Files
+		if w.options.SkipHidden && strings.HasPrefix(name, ".") {
+			continue
+		}
+
+		// Get File Info (following symlinks if enabled)
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			w.fail(err)
+			return
+		}
+		if info.Mode()&os.ModeSymlink != 0 && w.options.FollowSymlinks {
+			target, err := os.Stat(entryPath)
+			if err == nil {
+				info = target
+			}
+		}
+
+		// Apply Filters
+		if w.skip(entryRel, info.IsDir()) {
+			continue
+		}
+
+		err = w.fn(entryPath, info)
+		if err == filepath.SkipDir && info.IsDir() {
+			continue
+		}
+		if err != nil {
+			w.fail(err)
+			return
+		}
+
+		// Descend into Subdirectory
+		if !info.IsDir() || (w.options.MaxDepth > 0 && depth+1 >= w.options.MaxDepth) {
+			continue
+		}
+		select {
+		case w.workers <- struct{}{}:
+			w.wait.Add(1)
+			go func(dir string, rel string, depth int) {
+				defer w.wait.Done()
+				defer func() { <-w.workers }()
+				w.walkDirectory(dir, rel, depth)
+			}(entryPath, entryRel, depth+1)
+		default:
+			w.walkDirectory(entryPath, entryRel, depth+1)
+		}
+	}
+}
+
+// skip reports whether a path relative to the root is filtered out by Include, Exclude
+// or Ignore (the parents of a path are never walked when excluded, so only the path
+// itself is matched)
+func (w *walker) skip(rel string, isDir bool) bool {
+	if w.exclude.matches(rel, isDir) || w.options.Ignore.matches(rel, isDir) {
+		return true
+	}
+	return !isDir && !w.include.Empty() && !w.include.matches(rel, false)
+}
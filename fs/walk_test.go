@@ -0,0 +1,87 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// walkTree creates a small file tree for Walk unit tests
+func walkTree(t *testing.T) string {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"main.go", "README.md", ".env",
+		"cmd/app/app.go", "cmd/app/app_test.go",
+		"vendor/lib/lib.go", "build/out.bin",
+	} {
+		assert.NoError(t, TouchAll(filepath.Join(dir, name)))
+	}
+	return dir
+}
+
+// walkPaths walks a tree and returns the sorted slash-separated relative paths
+func walkPaths(t *testing.T, root string, options WalkOptions) []string {
+	var mutex sync.Mutex
+	paths := []string{}
+	err := Walk(root, options, func(path string, info os.FileInfo) error {
+		rel, _ := filepath.Rel(root, path)
+		mutex.Lock()
+		defer mutex.Unlock()
+		if rel != "." {
+			paths = append(paths, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	sort.Strings(paths)
+	return paths
+}
+
+// TestWalk is a unit test for fs.Walk()
+func TestWalk(t *testing.T) {
+	root := walkTree(t)
+
+	options := WalkOptions{
+		Include:    []string{"*.go"},
+		Exclude:    []string{"vendor/", "build", "*_test.go"},
+		SkipHidden: true,
+	}
+	expected := []string{"cmd", "cmd/app", "cmd/app/app.go", "main.go"}
+
+	// Assert Unit Test
+	assert.Equal(t, expected, walkPaths(t, root, options))
+
+	options.Concurrency = 4
+	assert.Equal(t, expected, walkPaths(t, root, options))
+
+	options = WalkOptions{MaxDepth: 1}
+	assert.Equal(t, []string{".env", "README.md", "build", "cmd", "main.go", "vendor"}, walkPaths(t, root, options))
+}
+
+// TestWalkFS is a unit test for fs.WalkFS()
+func TestWalkFS(t *testing.T) {
+	root := walkTree(t)
+
+	paths := []string{}
+	options := WalkOptions{Exclude: []string{"vendor/", "build"}, SkipHidden: true}
+	err := WalkFS(os.DirFS(root), ".", options, func(path string, info os.FileInfo) error {
+		paths = append(paths, path)
+		return nil
+	})
+
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, []string{".", "README.md", "cmd", "cmd/app", "cmd/app/app.go", "cmd/app/app_test.go", "main.go"}, paths)
+}
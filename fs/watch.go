@@ -0,0 +1,316 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Op describes the changes in a watch Event (a set of bits)
+type Op uint32
+
+// Watch Operations
+const (
+	Create Op = 1 << iota
+	Write
+	Remove
+	Rename
+	Chmod
+)
+
+// Has reports whether an Op includes all the operations in another Op
+func (op Op) Has(other Op) bool {
+	return op&other == other
+}
+
+// String returns the operations of an Op separated by "|" (e.g. "CREATE|WRITE")
+func (op Op) String() string {
+	var names []string
+	for _, name := range []struct {
+		op   Op
+		name string
+	}{{Create, "CREATE"}, {Write, "WRITE"}, {Remove, "REMOVE"}, {Rename, "RENAME"}, {Chmod, "CHMOD"}} {
+		if op.Has(name.op) {
+			names = append(names, name.name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// Event is a change to a watched path
+type Event struct {
+	Path string
+	Op   Op
+	Time time.Time
+}
+
+// WatchOptions configure Watch
+type WatchOptions struct {
+	// Recursive watches every subdirectory of the watched directories,
+	// including directories created after the watch starts
+	Recursive bool
+
+	// Debounce coalesces the events for a path until no further changes are
+	// seen for the duration (0 sends every event immediately)
+	Debounce time.Duration
+
+	// Exclude skips paths matching .gitignore-style rules relative to the watched path
+	Exclude []string
+
+	// Errors receives errors from the underlying watcher (optional)
+	Errors chan<- error
+}
+
+// Watcher watches paths for changes until closed
+type Watcher struct {
+	watcher *fsnotify.Watcher
+	events  chan<- Event
+	options WatchOptions
+	exclude *IgnoreMatcher
+	done    chan struct{}
+	wait    sync.WaitGroup
+
+	mutex   sync.Mutex
+	roots   map[string]string
+	pending map[string]*pendingEvent
+	closed  bool
+}
+
+// pendingEvent is an Event held back by WatchOptions.Debounce
+type pendingEvent struct {
+	op    Op
+	timer *time.Timer
+}
+
+// Watch simply watches files and directories for changes, sending an Event to the
+// events channel for each change (using inotify, FSEvents/kqueue or ReadDirectoryChangesW).
+// Call Close() on the returned Watcher to stop watching.
+func Watch(paths []string, events chan<- Event, options WatchOptions) (*Watcher, error) {
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watcher{
+		watcher: watcher,
+		events:  events,
+		options: options,
+		exclude: NewIgnoreMatcher(options.Exclude),
+		done:    make(chan struct{}),
+		roots:   map[string]string{},
+		pending: map[string]*pendingEvent{},
+	}
+
+	// Add Watched Paths
+	for _, path := range paths {
+		path = filepath.Clean(path)
+		if _, err := os.Stat(path); err != nil {
+			watcher.Close()
+			return nil, fmt.Errorf("Path '%v' doesn't exist", path)
+		}
+		err = w.add(path, path)
+		if err != nil {
+			watcher.Close()
+			return nil, err
+		}
+	}
+
+	w.wait.Add(1)
+	go w.run()
+
+	return w, nil
+}
+
+// Close stops watching and releases the underlying watcher, the events channel is not closed
+func (w *Watcher) Close() error {
+	w.mutex.Lock()
+	if w.closed {
+		w.mutex.Unlock()
+		return nil
+	}
+	w.closed = true
+	for _, pending := range w.pending {
+		pending.timer.Stop()
+	}
+	w.mutex.Unlock()
+
+	close(w.done)
+	err := w.watcher.Close()
+	w.wait.Wait()
+	return err
+}
+
+// add watches a path (and its subdirectories when recursive)
+func (w *Watcher) add(path string, root string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() || !w.options.Recursive {
+		return w.watch(path, root)
+	}
+
+	return Walk(path, WalkOptions{}, func(dir string, info os.FileInfo) error {
+		if !info.IsDir() {
+			return nil
+		}
+		if dir != root && w.excluded(dir, root, true) {
+			return filepath.SkipDir
+		}
+		return w.watch(dir, root)
+	})
+}
+
+// watch adds a single path to the underlying watcher
+func (w *Watcher) watch(path string, root string) error {
+	w.mutex.Lock()
+	w.roots[path] = root
+	w.mutex.Unlock()
+	return w.watcher.Add(path)
+}
+
+// root returns the watched path an event path belongs to
+func (w *Watcher) root(path string) string {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if root, ok := w.roots[path]; ok {
+		return root
+	}
+	if root, ok := w.roots[filepath.Dir(path)]; ok {
+		return root
+	}
+	return path
+}
+
+// excluded reports whether a path below a watched root matches WatchOptions.Exclude
+func (w *Watcher) excluded(path string, root string, isDir bool) bool {
+	if w.exclude.Empty() {
+		return false
+	}
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == "." {
+		return false
+	}
+	return w.exclude.Match(rel, isDir)
+}
+
+// run receives events from the underlying watcher until closed
+func (w *Watcher) run() {
+	defer w.wait.Done()
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			w.handle(event)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			if w.options.Errors != nil {
+				select {
+				case w.options.Errors <- err:
+				case <-w.done:
+				}
+			}
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// handle converts an fsnotify event to an Event, watching new directories when recursive
+func (w *Watcher) handle(event fsnotify.Event) {
+	path := filepath.Clean(event.Name)
+	root := w.root(path)
+
+	info, err := os.Lstat(path)
+	isDir := err == nil && info.IsDir()
+	if w.excluded(path, root, isDir) {
+		return
+	}
+
+	// Watch New Directories
+	if event.Has(fsnotify.Create) && isDir && w.options.Recursive {
+		if err := w.add(path, root); err != nil && w.options.Errors != nil {
+			select {
+			case w.options.Errors <- err:
+			case <-w.done:
+			}
+		}
+	}
+
+	op := convertOp(event.Op)
+	if op == 0 {
+		return
+	}
+	if w.options.Debounce <= 0 {
+		w.send(Event{Path: path, Op: op, Time: time.Now()})
+		return
+	}
+
+	// Debounce Events per Path
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.closed {
+		return
+	}
+	if pending, ok := w.pending[path]; ok {
+		pending.op |= op
+		pending.timer.Reset(w.options.Debounce)
+		return
+	}
+	pending := &pendingEvent{op: op}
+	pending.timer = time.AfterFunc(w.options.Debounce, func() {
+		w.mutex.Lock()
+		op := pending.op
+		delete(w.pending, path)
+		w.mutex.Unlock()
+		w.send(Event{Path: path, Op: op, Time: time.Now()})
+	})
+	w.pending[path] = pending
+}
+
+// send delivers an Event unless the Watcher is closed
+func (w *Watcher) send(event Event) {
+	select {
+	case w.events <- event:
+	case <-w.done:
+	}
+}
+
+// convertOp converts fsnotify operations to an Op
+func convertOp(op fsnotify.Op) Op {
+	var result Op
+	if op.Has(fsnotify.Create) {
+		result |= Create
+	}
+	if op.Has(fsnotify.Write) {
+		result |= Write
+	}
+	if op.Has(fsnotify.Remove) {
+		result |= Remove
+	}
+	if op.Has(fsnotify.Rename) {
+		result |= Rename
+	}
+	if op.Has(fsnotify.Chmod) {
+		result |= Chmod
+	}
+	return result
+}
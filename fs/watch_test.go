@@ -0,0 +1,49 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWatch is a unit test for fs.Watch()
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	events := make(chan Event, 16)
+
+	watcher, err := Watch([]string{dir}, events, WatchOptions{Recursive: true, Debounce: 50 * time.Millisecond})
+	assert.NoError(t, err)
+	defer watcher.Close()
+
+	// Create a File in a New Subdirectory
+	sub := filepath.Join(dir, "sub")
+	assert.NoError(t, os.Mkdir(sub, 0755))
+	time.Sleep(100 * time.Millisecond)
+	path := filepath.Join(sub, "test.txt")
+	assert.NoError(t, OverwriteFile(path, 0644, []byte("test")))
+
+	// Assert Unit Test
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event := <-events:
+			if event.Path == path {
+				assert.True(t, event.Op.Has(Create))
+				return
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for watch event")
+		}
+	}
+}
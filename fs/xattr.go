@@ -0,0 +1,60 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrXattrNotSupported is returned by the xattr functions on platforms without extended attributes
+var ErrXattrNotSupported = errors.New("extended attributes are not supported on this platform")
+
+// GetXattr simply returns the value of an extended attribute of a file
+// (e.g. "user.checksum" on Linux or "com.apple.quarantine" on macOS)
+func GetXattr(path string, name string) ([]byte, error) {
+
+	// Check IF Path Exists
+	_, err := os.Lstat(path)
+	if err != nil {
+		return nil, fmt.Errorf("Path '%v' doesn't exist", path)
+	}
+
+	value, err := getXattr(path, name)
+	if errors.Is(err, errNoAttribute) {
+		return nil, fmt.Errorf("Attribute '%v' doesn't exist on '%v'", name, path)
+	}
+	return value, err
+}
+
+// SetXattr simply sets the value of an extended attribute of a file,
+// creating the attribute or replacing its value
+func SetXattr(path string, name string, value []byte) error {
+
+	// Check IF Path Exists
+	_, err := os.Lstat(path)
+	if err != nil {
+		return fmt.Errorf("Path '%v' doesn't exist", path)
+	}
+
+	return setXattr(path, name, value)
+}
+
+// ListXattr simply returns the names of the extended attributes of a file
+func ListXattr(path string) ([]string, error) {
+
+	// Check IF Path Exists
+	_, err := os.Lstat(path)
+	if err != nil {
+		return nil, fmt.Errorf("Path '%v' doesn't exist", path)
+	}
+
+	return listXattr(path)
+}
@@ -0,0 +1,19 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build darwin
+// +build darwin
+
+package fs
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// errNoAttribute is the error returned for a missing extended attribute
+var errNoAttribute error = unix.ENOATTR
@@ -0,0 +1,30 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package fs
+
+// errNoAttribute is the error returned for a missing extended attribute
+var errNoAttribute = ErrXattrNotSupported
+
+// getXattr is not supported on this platform
+func getXattr(path string, name string) ([]byte, error) {
+	return nil, ErrXattrNotSupported
+}
+
+// setXattr is not supported on this platform
+func setXattr(path string, name string, value []byte) error {
+	return ErrXattrNotSupported
+}
+
+// listXattr is not supported on this platform
+func listXattr(path string) ([]string, error) {
+	return nil, ErrXattrNotSupported
+}
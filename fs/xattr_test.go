@@ -0,0 +1,39 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestXattr is a unit test for fs.SetXattr(), fs.GetXattr() and fs.ListXattr()
+func TestXattr(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.txt")
+	assert.NoError(t, Touch(path))
+
+	err := SetXattr(path, "user.gogo", []byte("value"))
+	if err != nil {
+		t.Skipf("extended attributes are not available: %v", err)
+	}
+
+	value, err := GetXattr(path, "user.gogo")
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, "value", string(value))
+
+	names, err := ListXattr(path)
+	assert.NoError(t, err)
+	assert.Contains(t, names, "user.gogo")
+
+	_, err = GetXattr(path, "user.missing")
+	assert.EqualError(t, err, "Attribute 'user.missing' doesn't exist on '"+path+"'")
+}
@@ -0,0 +1,101 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// Operating System used to choose base directories (replaceable in tests)
+var goos = runtime.GOOS
+
+// baseDirectory describes where a kind of application directory lives on each platform
+type baseDirectory struct {
+	xdgVariable string   // XDG environment variable (Linux and other Unix systems)
+	xdgDefault  []string // default path below the home directory when the variable is unset
+	darwin      []string // path below the home directory on macOS
+	windowsVar  string   // environment variable holding the base directory on Windows
+	windowsSub  string   // subdirectory of the application directory on Windows
+}
+
+// Base Directories
+var (
+	configBase = baseDirectory{"XDG_CONFIG_HOME", []string{".config"}, []string{"Library", "Application Support"}, "APPDATA", ""}
+	cacheBase  = baseDirectory{"XDG_CACHE_HOME", []string{".cache"}, []string{"Library", "Caches"}, "LOCALAPPDATA", "Cache"}
+	dataBase   = baseDirectory{"XDG_DATA_HOME", []string{".local", "share"}, []string{"Library", "Application Support"}, "LOCALAPPDATA", ""}
+	stateBase  = baseDirectory{"XDG_STATE_HOME", []string{".local", "state"}, []string{"Library", "Application Support"}, "LOCALAPPDATA", "State"}
+)
+
+// ConfigDir returns the directory for an application's configuration files
+// ($XDG_CONFIG_HOME/<app> or ~/.config/<app> on Linux, ~/Library/Application Support/<app>
+// on macOS and %APPDATA%\<app> on Windows). The directory is not created.
+func ConfigDir(appName string) (string, error) {
+	return configBase.path(appName)
+}
+
+// CacheDir returns the directory for an application's cache files
+// ($XDG_CACHE_HOME/<app> or ~/.cache/<app> on Linux, ~/Library/Caches/<app> on macOS
+// and %LOCALAPPDATA%\<app>\Cache on Windows). The directory is not created.
+func CacheDir(appName string) (string, error) {
+	return cacheBase.path(appName)
+}
+
+// DataDir returns the directory for an application's data files
+// ($XDG_DATA_HOME/<app> or ~/.local/share/<app> on Linux, ~/Library/Application Support/<app>
+// on macOS and %LOCALAPPDATA%\<app> on Windows). The directory is not created.
+func DataDir(appName string) (string, error) {
+	return dataBase.path(appName)
+}
+
+// StateDir returns the directory for an application's state files such as logs and history
+// ($XDG_STATE_HOME/<app> or ~/.local/state/<app> on Linux, ~/Library/Application Support/<app>
+// on macOS and %LOCALAPPDATA%\<app>\State on Windows). The directory is not created.
+func StateDir(appName string) (string, error) {
+	return stateBase.path(appName)
+}
+
+// path returns the application directory for the current platform
+func (base baseDirectory) path(appName string) (string, error) {
+
+	// Validate Application Name
+	if appName == "" {
+		return "", fmt.Errorf("The 'appName' parameter was empty. An application name is required")
+	}
+
+	switch goos {
+	case "windows":
+		dir := os.Getenv(base.windowsVar)
+		if dir == "" {
+			return "", fmt.Errorf("Environment variable '%%%v%%' is not set", base.windowsVar)
+		}
+		return filepath.Join(dir, appName, base.windowsSub), nil
+	case "darwin", "ios":
+		if dir := os.Getenv(base.xdgVariable); filepath.IsAbs(dir) {
+			return filepath.Join(dir, appName), nil
+		}
+		home, err := HomeDirectory()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(append(append([]string{home}, base.darwin...), appName)...), nil
+	}
+
+	// XDG Base Directory Specification (relative paths are ignored)
+	if dir := os.Getenv(base.xdgVariable); filepath.IsAbs(dir) {
+		return filepath.Join(dir, appName), nil
+	}
+	home, err := HomeDirectory()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(append(append([]string{home}, base.xdgDefault...), appName)...), nil
+}
@@ -0,0 +1,49 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestConfigDir is a unit test for fs.ConfigDir(), fs.CacheDir(), fs.DataDir() and fs.StateDir()
+func TestConfigDir(t *testing.T) {
+	defer func(saved string) { goos = saved }(goos)
+	goos = "linux"
+	home, err := HomeDirectory()
+	assert.NoError(t, err)
+
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+	t.Setenv("XDG_CACHE_HOME", "relative/cache")
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("XDG_STATE_HOME", "")
+
+	dir, err := ConfigDir("gogo")
+	// Assert Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, "xdg-config", "gogo"), dir)
+
+	dir, err = CacheDir("gogo")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".cache", "gogo"), dir)
+
+	dir, err = DataDir("gogo")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".local", "share", "gogo"), dir)
+
+	dir, err = StateDir("gogo")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".local", "state", "gogo"), dir)
+
+	_, err = ConfigDir("")
+	assert.Error(t, err)
+}
@@ -0,0 +1,60 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/fs
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ReadYAML simply reads a YAML file and unmarshals it into v
+func ReadYAML(path string, v interface{}) error {
+
+	// Read File
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("File '%v' doesn't exist", path)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to read file '%v': %w", path, err)
+	}
+
+	// Decode YAML
+	err = yaml.Unmarshal(data, v)
+	if err != nil {
+		return fmt.Errorf("File '%v' is not valid YAML: %v", path, err)
+	}
+
+	return nil
+}
+
+// WriteYAML marshals v as YAML (indented with two spaces) and atomically
+// writes it to a file, replacing any existing file
+func WriteYAML(path string, v interface{}, mode os.FileMode) error {
+
+	// Encode YAML
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	err := encoder.Encode(v)
+	if err != nil {
+		return err
+	}
+	err = encoder.Close()
+	if err != nil {
+		return err
+	}
+
+	// Write File
+	return WriteFileAtomic(path, mode, buf.Bytes())
+}
@@ -0,0 +1,170 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package audit provides an append-only audit log of JSON records with hash chaining for tamper evidence
+package audit
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Audit Record Outcomes
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+	OutcomeDenied  = "denied"
+)
+
+// Record is a single entry in the audit log. Each Record stores the Hash of the
+// previous Record (PrevHash), so modifying or removing a Record breaks the chain.
+type Record struct {
+	Timestamp time.Time `json:"timestamp"`
+	Actor     string    `json:"actor"`
+	Action    string    `json:"action"`
+	Target    string    `json:"target"`
+	Outcome   string    `json:"outcome"`
+	PrevHash  string    `json:"prev_hash"`
+	Hash      string    `json:"hash"`
+}
+
+// Log is an append-only audit log file with one JSON Record per line
+type Log struct {
+	mu       sync.Mutex
+	file     *os.File
+	lastHash string
+}
+
+// Open opens (or creates) the audit log file at path for appending.
+// The existing chain is verified before new Records can be appended.
+func Open(path string) (*Log, error) {
+
+	// Verify Existing Records and find the last Hash
+	lastHash, err := verify(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	// Open Audit Log File (Append Only)
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Log{file: file, lastHash: lastHash}, nil
+}
+
+// Append writes a Record to the audit log, setting Timestamp (when empty),
+// PrevHash and Hash, and returns the Record as written
+func (l *Log) Append(record Record) (Record, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Set Timestamp
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now()
+	}
+	record.Timestamp = record.Timestamp.UTC()
+
+	// Chain Record to Previous Record
+	record.PrevHash = l.lastHash
+	hash, err := hashRecord(record)
+	if err != nil {
+		return Record{}, err
+	}
+	record.Hash = hash
+
+	// Write Record
+	data, err := json.Marshal(record)
+	if err != nil {
+		return Record{}, err
+	}
+	_, err = l.file.Write(append(data, '\n'))
+	if err != nil {
+		return Record{}, err
+	}
+
+	// Save File Changes
+	err = l.file.Sync()
+	if err != nil {
+		return Record{}, err
+	}
+
+	l.lastHash = record.Hash
+	return record, nil
+}
+
+// Close closes the audit log file
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// Verify checks the hash chain of the audit log file at path and returns
+// an error identifying the first Record that has been modified or removed
+func Verify(path string) error {
+	_, err := verify(path)
+	return err
+}
+
+// verify checks the hash chain of an audit log file and returns the last Hash
+func verify(path string) (string, error) {
+
+	// Open Audit Log File
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	// Verify Each Record
+	var lastHash string
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var record Record
+		err = json.Unmarshal(scanner.Bytes(), &record)
+		if err != nil {
+			return "", fmt.Errorf("Audit log '%v' line %v is not a valid record: %v", path, line, err)
+		}
+
+		if record.PrevHash != lastHash {
+			return "", fmt.Errorf("Audit log '%v' line %v does not follow the previous record", path, line)
+		}
+
+		hash, err := hashRecord(record)
+		if err != nil {
+			return "", err
+		}
+		if record.Hash != hash {
+			return "", fmt.Errorf("Audit log '%v' line %v has been modified", path, line)
+		}
+
+		lastHash = record.Hash
+	}
+
+	return lastHash, scanner.Err()
+}
+
+// hashRecord returns the SHA-256 (hex) of a Record's JSON encoding without its Hash
+func hashRecord(record Record) (string, error) {
+	record.Hash = ""
+	data, err := json.Marshal(record)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
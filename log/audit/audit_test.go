@@ -0,0 +1,65 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAppend is a unit test for audit.Append() and audit.Verify()
+func TestAppend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	// Append Records
+	audit, err := Open(path)
+	assert.NoError(t, err)
+	first, err := audit.Append(Record{Actor: "brian", Action: "delete", Target: "/tmp/a", Outcome: OutcomeSuccess})
+	assert.NoError(t, err)
+	assert.NoError(t, audit.Close())
+
+	// Reopen and Append Records
+	audit, err = Open(path)
+	assert.NoError(t, err)
+	second, err := audit.Append(Record{Actor: "brian", Action: "delete", Target: "/tmp/b", Outcome: OutcomeFailure})
+	assert.NoError(t, err)
+	assert.NoError(t, audit.Close())
+
+	// Assert Unit Test
+	assert.Equal(t, "", first.PrevHash)
+	assert.Equal(t, first.Hash, second.PrevHash)
+	assert.NoError(t, Verify(path))
+}
+
+// TestVerifyTampered is a unit test for audit.Verify() with a modified record
+func TestVerifyTampered(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	// Append Records
+	audit, err := Open(path)
+	assert.NoError(t, err)
+	_, err = audit.Append(Record{Actor: "brian", Action: "chmod", Target: "/tmp/a", Outcome: OutcomeSuccess})
+	assert.NoError(t, err)
+	_, err = audit.Append(Record{Actor: "brian", Action: "chmod", Target: "/tmp/b", Outcome: OutcomeSuccess})
+	assert.NoError(t, err)
+	assert.NoError(t, audit.Close())
+
+	// Tamper with Record
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	err = os.WriteFile(path, []byte(strings.Replace(string(data), "/tmp/a", "/tmp/z", 1)), 0600)
+	assert.NoError(t, err)
+
+	// Assert Unit Test
+	assert.EqualError(t, Verify(path), "Audit log '"+path+"' line 1 has been modified")
+}
@@ -0,0 +1,65 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	"log"
+	"sync"
+)
+
+// Number of suppressed messages held by BufferUntilError()
+const bufferSize = 100
+
+// Flag to Enable Buffering of Suppressed Messages
+var bufferEnabled bool
+
+// Ring Buffer of formatted messages held until an Error occurs
+var (
+	bufferMutex sync.Mutex
+	buffered    []string
+	bufferStart int
+)
+
+// BufferUntilError holds the most recent VPrint, Debug and Trace messages that
+// would otherwise be suppressed in a ring buffer, and only writes them when a
+// Failure, Error, Panic or Fatal message is logged. This gives post-mortem
+// context without the noise of always-on debug logging.
+func BufferUntilError() {
+	bufferEnabled = true
+}
+
+// hold adds a suppressed Verbose, Debug or Trace message to the ring buffer
+func hold(level Level, message string) {
+	if !bufferEnabled || level > VerboseLevel {
+		return
+	}
+
+	bufferMutex.Lock()
+	defer bufferMutex.Unlock()
+
+	// Overwrite the Oldest Message once the Buffer is full
+	if len(buffered) < bufferSize {
+		buffered = append(buffered, render(level, message))
+		return
+	}
+	buffered[bufferStart] = render(level, message)
+	bufferStart = (bufferStart + 1) % bufferSize
+}
+
+// flush writes and clears any messages held in the ring buffer (oldest first)
+func flush() {
+	bufferMutex.Lock()
+	defer bufferMutex.Unlock()
+
+	for i := range buffered {
+		log.Print(buffered[(bufferStart+i)%len(buffered)])
+	}
+	buffered = nil
+	bufferStart = 0
+}
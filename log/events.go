@@ -0,0 +1,41 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	"sync"
+)
+
+// Number of Records buffered on the Events() channel
+const eventsSize = 256
+
+// Channel of Records returned by Events()
+var (
+	eventsOnce sync.Once
+	events     chan Record
+)
+
+// Events returns a channel that receives every Record written to the log, so
+// GUIs and TUIs can render log messages themselves (combine with Discard() to
+// stop writing text to Stdout). The first call enables the event stream.
+//
+// Records are sent without blocking the logger, so Records are dropped when
+// more than 256 Records are waiting to be received.
+func Events() <-chan Record {
+	eventsOnce.Do(func() {
+		events = make(chan Record, eventsSize)
+		AddHook(func(record Record) {
+			select {
+			case events <- record:
+			default:
+			}
+		})
+	})
+	return events
+}
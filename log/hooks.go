@@ -0,0 +1,39 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	"sync"
+)
+
+// Hook is called with every Record written to the log (including Panic
+// and Fatal messages, before the panic or exit)
+type Hook func(Record)
+
+// Hooks registered with AddHook()
+var (
+	hooksMutex sync.RWMutex
+	hooks      []Hook
+)
+
+// AddHook registers a Hook called with every Record written to the log
+func AddHook(hook Hook) {
+	hooksMutex.Lock()
+	defer hooksMutex.Unlock()
+	hooks = append(hooks, hook)
+}
+
+// runHooks calls every registered Hook with a Record
+func runHooks(record Record) {
+	hooksMutex.RLock()
+	defer hooksMutex.RUnlock()
+	for _, hook := range hooks {
+		hook(record)
+	}
+}
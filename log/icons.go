@@ -0,0 +1,43 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+// icon is a log message prefix with a Unicode glyph and an ASCII fallback
+type icon struct {
+	unicode string
+	ascii   string
+}
+
+// Icons printed before the label when EnableIcons() is set
+var (
+	successIcon = icon{unicode: "✔", ascii: "+"}
+	warningIcon = icon{unicode: "⚠", ascii: "!"}
+	errorIcon   = icon{unicode: "✖", ascii: "x"}
+)
+
+// String returns the icon followed by a space when icons are enabled,
+// or an empty string when icons are disabled
+func (i icon) String() string {
+	if glyph := i.glyph(); glyph != "" {
+		return glyph + " "
+	}
+	return ""
+}
+
+// glyph returns the Unicode or ASCII icon when icons are enabled,
+// or an empty string when icons are disabled
+func (i icon) glyph() string {
+	if !iconsEnabled {
+		return ""
+	}
+	if unicodeSupported {
+		return i.unicode
+	}
+	return i.ascii
+}
@@ -0,0 +1,86 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+// Labels are the literal strings printed before messages at each Level.
+// An empty label prints the message without a label.
+type Labels struct {
+	Trace      string
+	Debug      string
+	Verbose    string
+	Success    string
+	Notice     string
+	Warning    string
+	Deprecated string
+	Failure    string
+	Error      string
+	Panic      string
+	Fatal      string
+}
+
+// Labels printed before log messages (see SetLabels)
+var labels = DefaultLabels()
+
+// DefaultLabels returns the default (English) Labels
+func DefaultLabels() Labels {
+	return Labels{
+		Trace:      "TRACE:",
+		Debug:      "DEBUG:",
+		Verbose:    "INFO:",
+		Success:    "SUCCESS:",
+		Notice:     "NOTICE:",
+		Warning:    "WARNING:",
+		Deprecated: "DEPRECATED:",
+		Failure:    "FAILURE:",
+		Error:      "ERROR:",
+		Panic:      "PANIC:",
+		Fatal:      "FATAL:",
+	}
+}
+
+// SetLabels overrides the labels printed before log messages, e.g. for
+// non-English tooling or style guides. Start from DefaultLabels() to
+// override individual labels
+//
+//	labels := log.DefaultLabels()
+//	labels.Success = "OK:"
+//	labels.Warning = "WARN:"
+//	log.SetLabels(labels)
+func SetLabels(l Labels) {
+	labels = l
+}
+
+// label returns the label for a Level
+func (l Labels) label(level Level) string {
+	switch level {
+	case TraceLevel:
+		return l.Trace
+	case DebugLevel:
+		return l.Debug
+	case VerboseLevel:
+		return l.Verbose
+	case SuccessLevel:
+		return l.Success
+	case NoticeLevel:
+		return l.Notice
+	case WarningLevel:
+		return l.Warning
+	case DeprecatedLevel:
+		return l.Deprecated
+	case FailureLevel:
+		return l.Failure
+	case ErrorLevel:
+		return l.Error
+	case PanicLevel:
+		return l.Panic
+	case FatalLevel:
+		return l.Fatal
+	}
+	return ""
+}
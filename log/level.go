@@ -0,0 +1,108 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	auroraPackage "github.com/logrusorgru/aurora"
+)
+
+// Level identifies the kind of a log message, ordered from least to most severe
+type Level int
+
+// Log Levels
+const (
+	TraceLevel      Level = iota // Trace/Tracef
+	DebugLevel                   // Debug/Debugf
+	VerboseLevel                 // VPrint/VPrintf
+	InfoLevel                    // Print/Printf
+	SuccessLevel                 // Success/Successf
+	NoticeLevel                  // Notice/Noticef
+	WarningLevel                 // Warning/Warningf
+	DeprecatedLevel              // Deprecated/Deprecatedf
+	FailureLevel                 // Failure/Failuref
+	ErrorLevel                   // Error/Errorf
+	PanicLevel                   // Panic/Panicf
+	FatalLevel                   // Fatal/Fatalf
+)
+
+// levelNames maps each Level to its name
+var levelNames = map[Level]string{
+	TraceLevel:      "trace",
+	DebugLevel:      "debug",
+	VerboseLevel:    "verbose",
+	InfoLevel:       "info",
+	SuccessLevel:    "success",
+	NoticeLevel:     "notice",
+	WarningLevel:    "warning",
+	DeprecatedLevel: "deprecated",
+	FailureLevel:    "failure",
+	ErrorLevel:      "error",
+	PanicLevel:      "panic",
+	FatalLevel:      "fatal",
+}
+
+// levelStyle describes how a message at a Level is rendered
+type levelStyle struct {
+	icon  *icon
+	color func(auroraPackage.Aurora, interface{}) auroraPackage.Value
+}
+
+// levelStyles maps each Level to its icon and color
+var levelStyles = map[Level]levelStyle{
+	TraceLevel:      {},
+	DebugLevel:      {},
+	VerboseLevel:    {color: auroraPackage.Aurora.BrightCyan},
+	InfoLevel:       {color: auroraPackage.Aurora.BrightCyan},
+	SuccessLevel:    {icon: &successIcon, color: auroraPackage.Aurora.BrightGreen},
+	NoticeLevel:     {color: auroraPackage.Aurora.BrightBlue},
+	WarningLevel:    {icon: &warningIcon, color: auroraPackage.Aurora.BrightYellow},
+	DeprecatedLevel: {color: auroraPackage.Aurora.BrightMagenta},
+	FailureLevel:    {icon: &errorIcon, color: auroraPackage.Aurora.BrightRed},
+	ErrorLevel:      {icon: &errorIcon, color: auroraPackage.Aurora.BrightRed},
+	PanicLevel:      {color: auroraPackage.Aurora.BrightRed},
+	FatalLevel:      {color: auroraPackage.Aurora.BrightRed},
+}
+
+// String returns the name of the Level
+func (level Level) String() string {
+	if name, ok := levelNames[level]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// logAt logs a message using the log function for the given Level
+func logAt(level Level, message string) {
+	switch level {
+	case TraceLevel:
+		Trace(message)
+	case DebugLevel:
+		Debug(message)
+	case VerboseLevel:
+		VPrint(message)
+	case SuccessLevel:
+		Success(message)
+	case NoticeLevel:
+		Notice(message)
+	case WarningLevel:
+		Warning(message)
+	case DeprecatedLevel:
+		Deprecated(message)
+	case FailureLevel:
+		Failure(message)
+	case ErrorLevel:
+		Error(message)
+	case PanicLevel:
+		Panic(message)
+	case FatalLevel:
+		Fatal(message)
+	default:
+		Print(message)
+	}
+}
@@ -11,12 +11,14 @@ package log
 
 import (
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"sync"
+	"time"
 
+	"github.com/knowntraveler/gogo/term"
 	auroraPackage "github.com/logrusorgru/aurora"
-	colorable "github.com/onsi/ginkgo/reporters/stenographer/support/go-colorable"
-	isatty "github.com/onsi/ginkgo/reporters/stenographer/support/go-isatty"
 )
 
 var aurora auroraPackage.Aurora
@@ -30,132 +32,232 @@ var debugEnabled bool
 // Flog to Enable Trace Logging
 var traceEnabled bool
 
+// Flag to Enable Quiet Logging (suppresses messages below level Warn)
+var quietEnabled bool
+
+// Flag to Enable Icon Prefixes
+var iconsEnabled bool
+
+// Flag for Unicode Support on the Terminal
+var unicodeSupported bool
+
+// Deprecation Messages already logged by Deprecated/Deprecatedf
+var (
+	deprecationsMutex sync.Mutex
+	deprecations      = map[string]bool{}
+)
+
+// Function called by Fatal/Fatalf (replaceable in tests)
+var exit = os.Exit
+
+// Output saved by Discard() and restored by Restore()
+var (
+	discardMutex sync.Mutex
+	savedOutput  io.Writer
+)
+
 func init() {
-	aurora = auroraPackage.NewAurora(isatty.IsTerminal(os.Stdout.Fd()))
-	log.SetOutput(colorable.NewColorableStdout())
+	setColors(term.SupportsColor())
+	log.SetOutput(os.Stdout)
 	log.SetFlags(0)
+	unicodeSupported = term.SupportsUnicode()
+}
+
+// firstDeprecation reports whether a deprecation message is logged for the first time
+func firstDeprecation(message string) bool {
+	deprecationsMutex.Lock()
+	defer deprecationsMutex.Unlock()
+	if deprecations[message] {
+		return false
+	}
+	deprecations[message] = true
+	return true
+}
+
+// enabled reports whether messages at a Level are currently shown
+func enabled(level Level) bool {
+	switch level {
+	case TraceLevel:
+		return traceEnabled && !quietEnabled
+	case DebugLevel:
+		return debugEnabled && !quietEnabled
+	case VerboseLevel:
+		return verboseEnabled && !quietEnabled
+	case InfoLevel, SuccessLevel, NoticeLevel:
+		return !quietEnabled
+	}
+	return true
+}
+
+// output writes a message at a Level to the standard logger
+// when the Level is enabled by the global verbosity flags
+func output(level Level, message string) {
+	emit(level, message, enabled(level))
+}
+
+// emit is the pipeline for every log message. A Record is built and passed through
+// middleware and redactors, then written to the standard logger and passed to
+// hooks, or held for BufferUntilError() when show is false. Outputs added with
+// AddOutput() receive the Record based on their own Level. Messages at level
+// Panic and Fatal then panic or exit.
+func emit(level Level, message string, show bool) {
+	// Skip Suppressed Messages that are not Buffered or Written to an Output
+	if !show && !bufferEnabled && !hasOutputs() {
+		return
+	}
+
+	record := Record{
+		Time:    time.Now(),
+		Level:   level,
+		Message: message,
+	}
+	record = applyMiddleware(record)
+	record = redactRecord(record)
+	text := record.text()
+
+	if show {
+		// Flush Held Messages before an Error
+		if level >= FailureLevel {
+			flush()
+		}
+
+		log.Output(2, render(level, text))
+		count(record)
+		runHooks(record)
+	} else {
+		// Hold Suppressed Messages for BufferUntilError()
+		hold(level, text)
+	}
+	writeOutputs(record)
+
+	switch level {
+	case PanicLevel:
+		panic(record.Message)
+	case FatalLevel:
+		exit(1)
+	}
 }
 
 // Print logs a message at level Info
 func Print(message string) {
-	log.Printf(fmt.Sprintf(aurora.BrightCyan("%v").String(), message))
+	output(InfoLevel, message)
 }
 
 // Printf logs a formatted message at level Info
 func Printf(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	log.Printf(fmt.Sprintf(aurora.BrightCyan("%v").String(), message))
+	output(InfoLevel, fmt.Sprintf(format, args...))
 }
 
 // VPrint logs a message at level Info when verboseEnabled is true
 func VPrint(message string) {
-	if verboseEnabled {
-		log.Printf(fmt.Sprintf(aurora.BrightCyan("INFO: %v").String(), message))
-	}
+	output(VerboseLevel, message)
 }
 
 // VPrintf logs a message at level Info when verboseEnabled is true
 func VPrintf(format string, args ...interface{}) {
-	if verboseEnabled {
-		message := fmt.Sprintf(format, args...)
-		log.Printf(fmt.Sprintf(aurora.BrightCyan("INFO: %v").String(), message))
-	}
+	output(VerboseLevel, fmt.Sprintf(format, args...))
 }
 
 // Success logs a message at level Info
 func Success(message string) {
-	log.Printf(fmt.Sprintf(aurora.BrightGreen("SUCCESS: %v").String(), message))
+	output(SuccessLevel, message)
 }
 
 // Successf logs a formatted message at level Info
 func Successf(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	log.Printf(fmt.Sprintf(aurora.BrightGreen("SUCCESS: %v").String(), message))
+	output(SuccessLevel, fmt.Sprintf(format, args...))
+}
+
+// Notice logs a message at level Notice
+func Notice(message string) {
+	output(NoticeLevel, message)
+}
+
+// Noticef logs a formatted message at level Notice
+func Noticef(format string, args ...interface{}) {
+	output(NoticeLevel, fmt.Sprintf(format, args...))
 }
 
 // Warning logs a message at level Warn
 func Warning(message string) {
-	log.Printf(fmt.Sprintf(aurora.BrightYellow("WARNING: %v").String(), message))
+	output(WarningLevel, message)
 }
 
 // Warningf logs a formatted message at level Warn
 func Warningf(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	log.Printf(fmt.Sprintf(aurora.BrightYellow("WARNING: %v").String(), message))
+	output(WarningLevel, fmt.Sprintf(format, args...))
+}
+
+// Deprecated logs a message at level Deprecated, once per unique message
+func Deprecated(message string) {
+	if firstDeprecation(message) {
+		output(DeprecatedLevel, message)
+	}
+}
+
+// Deprecatedf logs a formatted message at level Deprecated, once per unique message
+func Deprecatedf(format string, args ...interface{}) {
+	Deprecated(fmt.Sprintf(format, args...))
 }
 
 // Failure logs a message at level Error
 func Failure(message string) {
-	log.Printf(fmt.Sprintf(aurora.BrightRed("FAILURE: %v").String(), message))
+	output(FailureLevel, message)
 }
 
 // Failuref logs a formatted message at level Error
 func Failuref(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	log.Printf(fmt.Sprintf(aurora.BrightRed("FAILURE: %v").String(), message))
+	output(FailureLevel, fmt.Sprintf(format, args...))
 }
 
 // Error logs a message at level Error
 func Error(message string) {
-	log.Printf(fmt.Sprintf(aurora.BrightRed("ERROR: %v").String(), message))
+	output(ErrorLevel, message)
 }
 
 // Errorf logs a message at level Error
 func Errorf(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	log.Printf(fmt.Sprintf(aurora.BrightRed("ERROR: %v").String(), message))
+	output(ErrorLevel, fmt.Sprintf(format, args...))
 }
 
-// Panic logs a message at level Panic
+// Panic logs a message at level Panic, then panics with the message
 func Panic(message string) {
-	log.Panicf(fmt.Sprintf(aurora.BrightRed("PANIC: %v").String(), message))
+	output(PanicLevel, message)
 }
 
-// Panicf logs a formatted message at level Panic
+// Panicf logs a formatted message at level Panic, then panics with the message
 func Panicf(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	log.Panicf(fmt.Sprintf(aurora.BrightRed("PANIC: %v").String(), message))
+	output(PanicLevel, fmt.Sprintf(format, args...))
 }
 
-// Fatal logs a message at level Fatal
+// Fatal logs a message at level Fatal, then exits with status 1
 func Fatal(message string) {
-	log.Fatalf(fmt.Sprintf(aurora.BrightRed("FATAL: %v").String(), message))
+	output(FatalLevel, message)
 }
 
-// Fatalf logs a formatted message at level Fatal
+// Fatalf logs a formatted message at level Fatal, then exits with status 1
 func Fatalf(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	log.Fatalf(fmt.Sprintf(aurora.BrightRed("FATAL: %v").String(), message))
+	output(FatalLevel, fmt.Sprintf(format, args...))
 }
 
 // Debug logs a message at level Debug
 func Debug(message string) {
-	if debugEnabled {
-		log.Printf("DEBUG: %v", message)
-	}
+	output(DebugLevel, message)
 }
 
 // Debugf logs a formatted message at level Debug
 func Debugf(format string, args ...interface{}) {
-	if debugEnabled {
-		message := fmt.Sprintf(format, args...)
-		log.Printf("DEBUG: %v", message)
-	}
+	output(DebugLevel, fmt.Sprintf(format, args...))
 }
 
 // Trace logs a message at level Trace
 func Trace(message string) {
-	if traceEnabled {
-		log.Printf("TRACE: %v", message)
-	}
+	output(TraceLevel, message)
 }
 
 // Tracef logs a formatted message at level Trace
 func Tracef(format string, args ...interface{}) {
-	if traceEnabled {
-		message := fmt.Sprintf(format, args...)
-		log.Printf("TRACE: %v", message)
-	}
+	output(TraceLevel, fmt.Sprintf(format, args...))
 }
 
 // EnableVerbose turns on verbose logging
@@ -172,3 +274,38 @@ func EnableDebug() {
 func EnableTrace() {
 	traceEnabled = true
 }
+
+// EnableQuiet turns on quiet logging, suppressing Print, VPrint, Success, Notice,
+// Debug and Trace messages while still showing Warnings, Failures and Errors
+func EnableQuiet() {
+	quietEnabled = true
+}
+
+// EnableIcons turns on icon prefixes for Success, Warning, Failure and Error
+// log messages (falls back to ASCII icons on terminals without Unicode support)
+func EnableIcons() {
+	iconsEnabled = true
+}
+
+// Discard swaps the log output for io.Discard (e.g. in unit tests of applications
+// using gogo/log), call Restore() to swap the previous output back
+func Discard() {
+	discardMutex.Lock()
+	defer discardMutex.Unlock()
+
+	if savedOutput == nil {
+		savedOutput = log.Writer()
+		log.SetOutput(io.Discard)
+	}
+}
+
+// Restore swaps back the log output replaced by Discard()
+func Restore() {
+	discardMutex.Lock()
+	defer discardMutex.Unlock()
+
+	if savedOutput != nil {
+		log.SetOutput(savedOutput)
+		savedOutput = nil
+	}
+}
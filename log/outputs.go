@@ -0,0 +1,78 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// logOutput is an additional output with its own minimum Level
+type logOutput struct {
+	mu     sync.Mutex
+	writer io.Writer
+	level  Level
+}
+
+// Outputs registered with AddOutput()
+var (
+	outputsMutex sync.RWMutex
+	outputs      []*logOutput
+)
+
+// AddOutput adds an output (e.g. a log file) that receives every message at or
+// above level, independent of the console verbosity, so full diagnostics can be
+// captured on disk without overwhelming the terminal
+//
+//	file, _ := os.OpenFile("mytool.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+//	log.AddOutput(file, log.TraceLevel)
+//
+// Messages are written without colors, one line per message, prefixed with
+// an RFC 3339 timestamp.
+func AddOutput(writer io.Writer, level Level) {
+	outputsMutex.Lock()
+	defer outputsMutex.Unlock()
+	outputs = append(outputs, &logOutput{writer: writer, level: level})
+}
+
+// hasOutputs reports whether any outputs have been added
+func hasOutputs() bool {
+	outputsMutex.RLock()
+	defer outputsMutex.RUnlock()
+	return len(outputs) > 0
+}
+
+// writeOutputs writes a Record to every output whose Level it meets
+func writeOutputs(record Record) {
+	outputsMutex.RLock()
+	defer outputsMutex.RUnlock()
+
+	var line []byte
+	for _, out := range outputs {
+		if record.Level < out.level {
+			continue
+		}
+		if line == nil {
+			line = renderPlain(record)
+		}
+		out.mu.Lock()
+		out.writer.Write(line)
+		out.mu.Unlock()
+	}
+}
+
+// renderPlain renders a Record as an uncolored line with a timestamp, label and fields
+func renderPlain(record Record) []byte {
+	line := record.Time.Format(time.RFC3339) + " "
+	if label := labels.label(record.Level); label != "" {
+		line += label + " "
+	}
+	return []byte(line + record.text() + "\n")
+}
@@ -0,0 +1,110 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Record is a log message as it passes through middleware, hooks and outputs
+type Record struct {
+	Time    time.Time
+	Level   Level
+	Message string
+	Fields  map[string]interface{} // written after the Message as key=value pairs
+}
+
+// Middleware enriches or rewrites a Record before it is formatted,
+// e.g. to add the hostname, PID or version to every Record
+type Middleware func(Record) Record
+
+// Middleware registered with Use()
+var (
+	middlewareMutex sync.RWMutex
+	middleware      []Middleware
+)
+
+// Clone returns a copy of the Record that does not share its Fields
+func (r Record) Clone() Record {
+	clone := r
+	if r.Fields != nil {
+		clone.Fields = make(map[string]interface{}, len(r.Fields))
+		for key, value := range r.Fields {
+			clone.Fields[key] = value
+		}
+	}
+	return clone
+}
+
+// WithField returns a copy of the Record with a field added
+func (r Record) WithField(key string, value interface{}) Record {
+	clone := r.Clone()
+	if clone.Fields == nil {
+		clone.Fields = map[string]interface{}{}
+	}
+	clone.Fields[key] = value
+	return clone
+}
+
+// text returns the Message followed by the Fields as key=value pairs (sorted by key)
+func (r Record) text() string {
+	if len(r.Fields) == 0 {
+		return r.Message
+	}
+
+	keys := make([]string, 0, len(r.Fields))
+	for key := range r.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var text strings.Builder
+	text.WriteString(r.Message)
+	for _, key := range keys {
+		value := fmt.Sprint(r.Fields[key])
+		if value == "" || strings.ContainsAny(value, " =\"") {
+			value = strconv.Quote(value)
+		}
+		text.WriteString(" " + key + "=" + value)
+	}
+	return text.String()
+}
+
+// Use appends middleware to the chain applied to every Record, in order,
+// before it is formatted
+//
+//	hostname, _ := os.Hostname()
+//	log.Use(func(r log.Record) log.Record {
+//		return r.WithField("host", hostname).WithField("pid", os.Getpid())
+//	})
+//
+// Middleware may change the Message and Fields of a Record, but not its Level.
+func Use(m ...Middleware) {
+	middlewareMutex.Lock()
+	defer middlewareMutex.Unlock()
+	middleware = append(middleware, m...)
+}
+
+// applyMiddleware passes a Record through the middleware chain
+func applyMiddleware(record Record) Record {
+	middlewareMutex.RLock()
+	defer middlewareMutex.RUnlock()
+
+	level := record.Level
+	for _, m := range middleware {
+		record = m(record.Clone())
+	}
+	record.Level = level
+	return record
+}
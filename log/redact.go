@@ -0,0 +1,118 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// Redacted replaces sensitive values matched by RegisterRedactPattern()
+const Redacted = "[REDACTED]"
+
+// Redactor masks sensitive values in a log message
+type Redactor func(message string) string
+
+// Redactors registered with RegisterRedactor()
+var (
+	redactorsMutex sync.RWMutex
+	redactors      []Redactor
+)
+
+// Patterns for Common Secrets masked by RedactCommonSecrets()
+var commonSecrets = []string{
+	`\b((?:AKIA|ASIA)[0-9A-Z]{16})\b`,                                                // AWS Access Key ID
+	`(?i)aws_secret_access_key\s*[=:]\s*"?([A-Za-z0-9/+=]{40})`,                      // AWS Secret Access Key
+	`(?i)\bbearer\s+([A-Za-z0-9\-._~+/]+=*)`,                                         // Bearer Token
+	`://[^/\s:@]+:([^/\s@]+)@`,                                                       // Password in URL
+	`(?i)\b(?:password|passwd|pwd|secret|token|api[_-]?key)\b\s*[=:]\s*"?([^\s"&]+)`, // key=value Secrets
+	`\b(gh[pousr]_[A-Za-z0-9]{36,})\b`,                                               // GitHub Token
+}
+
+// RegisterRedactor registers a Redactor applied to every log message (after
+// formatting) and field value before it is written, buffered or passed to hooks
+func RegisterRedactor(redactor Redactor) {
+	redactorsMutex.Lock()
+	defer redactorsMutex.Unlock()
+	redactors = append(redactors, redactor)
+}
+
+// RegisterRedactPattern registers a regular expression whose matches are replaced
+// with [REDACTED] in every log message. When the pattern has capture groups only
+// the text matched by the groups is replaced, e.g. `password=(\S+)`.
+func RegisterRedactPattern(pattern string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	RegisterRedactor(func(message string) string {
+		return redactPattern(re, message)
+	})
+	return nil
+}
+
+// RedactCommonSecrets registers patterns masking common secrets: AWS keys,
+// bearer and GitHub tokens, passwords in URLs, and password/token/secret/api_key
+// key=value pairs
+func RedactCommonSecrets() {
+	for _, pattern := range commonSecrets {
+		RegisterRedactPattern(pattern)
+	}
+}
+
+// redact applies every registered Redactor to a message
+func redact(message string) string {
+	redactorsMutex.RLock()
+	defer redactorsMutex.RUnlock()
+	for _, redactor := range redactors {
+		message = redactor(message)
+	}
+	return message
+}
+
+// redactRecord applies every registered Redactor to the Message and Fields of a Record
+func redactRecord(record Record) Record {
+	record.Message = redact(record.Message)
+	if len(record.Fields) == 0 {
+		return record
+	}
+
+	record = record.Clone()
+	for key, value := range record.Fields {
+		text := fmt.Sprint(value)
+		if redacted := redact(text); redacted != text {
+			record.Fields[key] = redacted
+		}
+	}
+	return record
+}
+
+// redactPattern replaces the matches (or capture groups) of a pattern with [REDACTED]
+func redactPattern(re *regexp.Regexp, message string) string {
+	if re.NumSubexp() == 0 {
+		return re.ReplaceAllLiteralString(message, Redacted)
+	}
+
+	result := []byte{}
+	last := 0
+	for _, match := range re.FindAllStringSubmatchIndex(message, -1) {
+		// Replace each Capture Group
+		for group := 2; group < len(match); group += 2 {
+			start, end := match[group], match[group+1]
+			if start < last {
+				continue
+			}
+			result = append(result, message[last:start]...)
+			result = append(result, Redacted...)
+			last = end
+		}
+	}
+	return string(append(result, message[last:]...))
+}
@@ -0,0 +1,94 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+
+	auroraPackage "github.com/logrusorgru/aurora"
+)
+
+// colorCode holds the escape sequences that start and reset the color of a Level
+type colorCode struct {
+	start string
+	reset string
+}
+
+// Escape Sequences for each Level (computed once by setColors)
+var colorCodes [FatalLevel + 1]colorCode
+
+// Pool of Buffers for rendering messages
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// setColors enables or disables colors and pre-computes the escape sequences for each Level
+func setColors(enabled bool) {
+	aurora = auroraPackage.NewAurora(enabled)
+	for level, style := range levelStyles {
+		if style.color == nil {
+			colorCodes[level] = colorCode{}
+			continue
+		}
+		codes := strings.SplitN(style.color(aurora, "\x00").String(), "\x00", 2)
+		colorCodes[level] = colorCode{start: codes[0], reset: codes[1]}
+	}
+}
+
+// render renders a message with the icon, label and color for a Level
+func render(level Level, message string) string {
+	buffer := bufferPool.Get().(*bytes.Buffer)
+	buffer.Reset()
+	defer bufferPool.Put(buffer)
+
+	code := colorCodes[level]
+	var glyph string
+	if style := levelStyles[level]; style.icon != nil {
+		glyph = style.icon.glyph()
+	}
+	label := labels.label(level)
+
+	// Fast Path: Single Line without Wrapping or Truncation
+	if overflow == overflowNone && strings.IndexByte(message, '\n') < 0 {
+		buffer.WriteString(code.start)
+		if glyph != "" {
+			buffer.WriteString(glyph)
+			buffer.WriteByte(' ')
+		}
+		if label != "" {
+			buffer.WriteString(label)
+			buffer.WriteByte(' ')
+		}
+		buffer.WriteString(message)
+		buffer.WriteString(code.reset)
+		return buffer.String()
+	}
+
+	// Color Each Line (so escape sequences never span a line break)
+	prefix := ""
+	if glyph != "" {
+		prefix = glyph + " "
+	}
+	if label != "" {
+		prefix += label + " "
+	}
+	for i, line := range strings.Split(layout(prefix, message), "\n") {
+		if i > 0 {
+			buffer.WriteByte('\n')
+		}
+		buffer.WriteString(code.start)
+		buffer.WriteString(line)
+		buffer.WriteString(code.reset)
+	}
+	return buffer.String()
+}
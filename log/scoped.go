@@ -0,0 +1,161 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	"fmt"
+)
+
+// Logger is a derived logger with its own minimum Level, which overrides the
+// global EnableVerbose/EnableDebug/EnableTrace/EnableQuiet settings. This allows
+// a noisy subsystem to be muted (or a single subsystem to be debugged) without
+// changing the verbosity of the rest of the application.
+type Logger struct {
+	level Level
+}
+
+// WithLevel returns a derived Logger that shows messages at or above level
+//
+//	db := log.WithLevel(log.WarningLevel) // mute a noisy subsystem
+//	api := log.WithLevel(log.TraceLevel)  // trace a single subsystem
+func WithLevel(level Level) *Logger {
+	return &Logger{level: level}
+}
+
+// Level returns the minimum Level shown by the Logger
+func (l *Logger) Level() Level {
+	return l.level
+}
+
+// output writes a message at a Level when it is at or above the Logger's Level
+func (l *Logger) output(level Level, message string) {
+	emit(level, message, level >= l.level)
+}
+
+// Print logs a message at level Info
+func (l *Logger) Print(message string) {
+	l.output(InfoLevel, message)
+}
+
+// Printf logs a formatted message at level Info
+func (l *Logger) Printf(format string, args ...interface{}) {
+	l.output(InfoLevel, fmt.Sprintf(format, args...))
+}
+
+// VPrint logs a message at level Verbose
+func (l *Logger) VPrint(message string) {
+	l.output(VerboseLevel, message)
+}
+
+// VPrintf logs a formatted message at level Verbose
+func (l *Logger) VPrintf(format string, args ...interface{}) {
+	l.output(VerboseLevel, fmt.Sprintf(format, args...))
+}
+
+// Success logs a message at level Success
+func (l *Logger) Success(message string) {
+	l.output(SuccessLevel, message)
+}
+
+// Successf logs a formatted message at level Success
+func (l *Logger) Successf(format string, args ...interface{}) {
+	l.output(SuccessLevel, fmt.Sprintf(format, args...))
+}
+
+// Notice logs a message at level Notice
+func (l *Logger) Notice(message string) {
+	l.output(NoticeLevel, message)
+}
+
+// Noticef logs a formatted message at level Notice
+func (l *Logger) Noticef(format string, args ...interface{}) {
+	l.output(NoticeLevel, fmt.Sprintf(format, args...))
+}
+
+// Warning logs a message at level Warn
+func (l *Logger) Warning(message string) {
+	l.output(WarningLevel, message)
+}
+
+// Warningf logs a formatted message at level Warn
+func (l *Logger) Warningf(format string, args ...interface{}) {
+	l.output(WarningLevel, fmt.Sprintf(format, args...))
+}
+
+// Deprecated logs a message at level Deprecated, once per unique message
+func (l *Logger) Deprecated(message string) {
+	if firstDeprecation(message) {
+		l.output(DeprecatedLevel, message)
+	}
+}
+
+// Deprecatedf logs a formatted message at level Deprecated, once per unique message
+func (l *Logger) Deprecatedf(format string, args ...interface{}) {
+	l.Deprecated(fmt.Sprintf(format, args...))
+}
+
+// Failure logs a message at level Failure
+func (l *Logger) Failure(message string) {
+	l.output(FailureLevel, message)
+}
+
+// Failuref logs a formatted message at level Failure
+func (l *Logger) Failuref(format string, args ...interface{}) {
+	l.output(FailureLevel, fmt.Sprintf(format, args...))
+}
+
+// Error logs a message at level Error
+func (l *Logger) Error(message string) {
+	l.output(ErrorLevel, message)
+}
+
+// Errorf logs a formatted message at level Error
+func (l *Logger) Errorf(format string, args ...interface{}) {
+	l.output(ErrorLevel, fmt.Sprintf(format, args...))
+}
+
+// Panic logs a message at level Panic
+func (l *Logger) Panic(message string) {
+	l.output(PanicLevel, message)
+}
+
+// Panicf logs a formatted message at level Panic
+func (l *Logger) Panicf(format string, args ...interface{}) {
+	l.output(PanicLevel, fmt.Sprintf(format, args...))
+}
+
+// Fatal logs a message at level Fatal
+func (l *Logger) Fatal(message string) {
+	l.output(FatalLevel, message)
+}
+
+// Fatalf logs a formatted message at level Fatal
+func (l *Logger) Fatalf(format string, args ...interface{}) {
+	l.output(FatalLevel, fmt.Sprintf(format, args...))
+}
+
+// Debug logs a message at level Debug
+func (l *Logger) Debug(message string) {
+	l.output(DebugLevel, message)
+}
+
+// Debugf logs a formatted message at level Debug
+func (l *Logger) Debugf(format string, args ...interface{}) {
+	l.output(DebugLevel, fmt.Sprintf(format, args...))
+}
+
+// Trace logs a message at level Trace
+func (l *Logger) Trace(message string) {
+	l.output(TraceLevel, message)
+}
+
+// Tracef logs a formatted message at level Trace
+func (l *Logger) Tracef(format string, args ...interface{}) {
+	l.output(TraceLevel, fmt.Sprintf(format, args...))
+}
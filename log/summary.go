@@ -0,0 +1,99 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+)
+
+// Counts and Replayed Records for Summary()
+var (
+	summaryMutex  sync.Mutex
+	summaryCounts = map[Level]int{}
+	summaryReplay int
+	replayed      []Record
+)
+
+// SetSummaryReplay sets the number of Failure and Error messages (the first n)
+// that are replayed by Summary()
+func SetSummaryReplay(n int) {
+	summaryMutex.Lock()
+	defer summaryMutex.Unlock()
+	summaryReplay = n
+}
+
+// Count returns the number of messages written at a Level during the run
+func Count(level Level) int {
+	summaryMutex.Lock()
+	defer summaryMutex.Unlock()
+	return summaryCounts[level]
+}
+
+// Summary prints the number of warnings, failures and errors written during the
+// run, followed by the first Failure and Error messages (see SetSummaryReplay),
+// so long batch jobs end with a digest the operator can act on
+//
+//	Summary: 3 warnings, 0 failures, 2 errors
+//	    ERROR: Failed to upload 'a.zip'
+//	    ERROR: Failed to upload 'b.zip'
+func Summary() {
+	summaryMutex.Lock()
+	defer summaryMutex.Unlock()
+
+	warnings := summaryCounts[WarningLevel] + summaryCounts[DeprecatedLevel]
+	failures := summaryCounts[FailureLevel]
+	errors := summaryCounts[ErrorLevel]
+
+	// Color the Summary by the most severe Level
+	level := SuccessLevel
+	switch {
+	case failures > 0 || errors > 0:
+		level = ErrorLevel
+	case warnings > 0:
+		level = WarningLevel
+	}
+
+	lines := []string{fmt.Sprintf("Summary: %v, %v, %v",
+		plural(warnings, "warning"), plural(failures, "failure"), plural(errors, "error"))}
+	for _, record := range replayed {
+		lines = append(lines, blockIndent+labels.label(record.Level)+" "+record.text())
+	}
+
+	code := colorCodes[level]
+	for i, line := range lines {
+		lines[i] = code.start + line + code.reset
+	}
+	log.Output(2, strings.Join(lines, "\n"))
+}
+
+// count adds a Record written to the log to the Summary() counts
+func count(record Record) {
+	if record.Level < WarningLevel || record.Level > ErrorLevel {
+		return
+	}
+
+	summaryMutex.Lock()
+	defer summaryMutex.Unlock()
+
+	summaryCounts[record.Level]++
+	if record.Level >= FailureLevel && len(replayed) < summaryReplay {
+		replayed = append(replayed, record)
+	}
+}
+
+// plural formats a count with a singular or plural noun (e.g. "1 error", "2 errors")
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%v %v", n, noun)
+	}
+	return fmt.Sprintf("%v %vs", n, noun)
+}
@@ -0,0 +1,53 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	"fmt"
+	"time"
+)
+
+// Level used by Timer.Stop() to log the duration (VPrint by default)
+var timerLevel = VerboseLevel
+
+// Timer measures the duration of a named operation
+type Timer struct {
+	name  string
+	start time.Time
+	level Level
+}
+
+// StartTimer starts a named Timer, call Stop() to log the duration
+func StartTimer(name string) *Timer {
+	return &Timer{
+		name:  name,
+		start: time.Now(),
+		level: timerLevel,
+	}
+}
+
+// Stop logs "<name> completed in <duration>" at the configured timer level
+// and returns the elapsed duration
+func (t *Timer) Stop() time.Duration {
+	elapsed := time.Since(t.start)
+	logAt(t.level, fmt.Sprintf("%v completed in %.2fs", t.name, elapsed.Seconds()))
+	return elapsed
+}
+
+// TimeFunc runs fn and logs its duration as a named Timer
+func TimeFunc(name string, fn func()) time.Duration {
+	timer := StartTimer(name)
+	fn()
+	return timer.Stop()
+}
+
+// SetTimerLevel sets the Level used to log Timer durations
+func SetTimerLevel(level Level) {
+	timerLevel = level
+}
@@ -0,0 +1,160 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/knowntraveler/gogo/term"
+)
+
+// Indent for the body of a Block
+const blockIndent = "    "
+
+// overflowMode controls how messages wider than the terminal are handled
+type overflowMode int
+
+// Overflow Modes
+const (
+	overflowNone     overflowMode = iota // let the terminal wrap long lines
+	overflowWrap                         // soft-wrap long lines with a hanging indent
+	overflowTruncate                     // truncate long lines with an ellipsis
+)
+
+// Mode for Messages wider than the Terminal
+var overflow = overflowNone
+
+// Width of the Terminal (replaceable in tests)
+var terminalWidth = term.Width
+
+// EnableWrap turns on soft-wrapping of messages wider than the terminal,
+// with continuation lines indented to align with the message after the label
+func EnableWrap() {
+	overflow = overflowWrap
+}
+
+// EnableTruncate turns on truncation of messages wider than the terminal,
+// ending truncated lines with an ellipsis
+func EnableTruncate() {
+	overflow = overflowTruncate
+}
+
+// Block logs a titled block at a Level, with the body indented and wrapped
+// to the terminal width (e.g. for long error explanations or remediation hints)
+//
+//	ERROR: Failed to read configuration
+//	    The file ~/.mytool/config.yaml is not valid YAML. Run 'mytool init'
+//	    to create a new configuration file.
+func Block(level Level, title string, body string) {
+	lines := []string{title}
+	for _, line := range wrap(body, terminalWidth()-len(blockIndent)) {
+		if line == "" {
+			lines = append(lines, line)
+			continue
+		}
+		lines = append(lines, blockIndent+line)
+	}
+	logAt(level, strings.Join(lines, "\n"))
+}
+
+// layout adds a prefix (icon and label) to the first line of a message,
+// and wraps or truncates lines wider than the terminal
+func layout(prefix string, message string) string {
+	lines := strings.Split(message, "\n")
+	lines[0] = prefix + lines[0]
+	if overflow == overflowNone {
+		return strings.Join(lines, "\n")
+	}
+
+	width := terminalWidth()
+	var result []string
+	for i, line := range lines {
+		// Line fits the Terminal
+		if utf8.RuneCountInString(line) <= width {
+			result = append(result, line)
+			continue
+		}
+
+		// Truncate Line
+		if overflow == overflowTruncate {
+			result = append(result, truncate(line, width))
+			continue
+		}
+
+		// Wrap Line with a Hanging Indent (prefix for the first line,
+		// leading whitespace for other lines, e.g. the body of a Block)
+		head := line[:len(line)-len(strings.TrimLeft(line, " "))]
+		if i == 0 {
+			head = prefix
+		}
+		indent := strings.Repeat(" ", utf8.RuneCountInString(head))
+		for j, wrapped := range wrap(strings.TrimPrefix(line, head), width-len(indent)) {
+			if j == 0 {
+				result = append(result, head+wrapped)
+				continue
+			}
+			result = append(result, indent+wrapped)
+		}
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// truncate shortens a line to width (in runes) ending with an ellipsis
+func truncate(line string, width int) string {
+	ellipsis := "..."
+	if unicodeSupported {
+		ellipsis = "…"
+	}
+	keep := width - utf8.RuneCountInString(ellipsis)
+	if keep < 0 {
+		keep = 0
+	}
+	return string([]rune(line)[:keep]) + ellipsis
+}
+
+// wrap splits text into lines no wider than width (in runes), breaking on
+// spaces where possible. Existing newlines in text are preserved.
+func wrap(text string, width int) []string {
+	if width < 1 {
+		width = 1
+	}
+
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			// Break Words Longer than Width
+			for utf8.RuneCountInString(word) > width {
+				if line != "" {
+					lines = append(lines, line)
+					line = ""
+				}
+				runes := []rune(word)
+				lines = append(lines, string(runes[:width]))
+				word = string(runes[width:])
+			}
+
+			// Start a New Line when the Word does not fit
+			switch {
+			case line == "":
+				line = word
+			case utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) > width:
+				lines = append(lines, line)
+				line = word
+			default:
+				line += " " + word
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
@@ -0,0 +1,69 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/log
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package log
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+)
+
+// levelWriter is an io.Writer that logs each complete line written to it at a Level
+type levelWriter struct {
+	mu     sync.Mutex
+	level  Level
+	buffer bytes.Buffer
+}
+
+// WriterAt returns an io.Writer that logs each line written to it at the given Level,
+// so the output of a subprocess can be streamed through the logger
+//
+//	cmd := exec.Command("make")
+//	cmd.Stdout = log.WriterAt(log.VerboseLevel)
+//	cmd.Stderr = log.WriterAt(log.ErrorLevel)
+//
+// Partial lines are buffered until a newline is written. Call Close() to
+// flush a final line that does not end with a newline.
+func WriterAt(level Level) io.WriteCloser {
+	return &levelWriter{level: level}
+}
+
+// Write buffers p and logs every complete line
+func (w *levelWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buffer.Write(p)
+
+	// Log Complete Lines
+	for {
+		index := bytes.IndexByte(w.buffer.Bytes(), '\n')
+		if index < 0 {
+			break
+		}
+		line := string(w.buffer.Next(index + 1))
+		logAt(w.level, strings.TrimRight(line, "\r\n"))
+	}
+
+	return len(p), nil
+}
+
+// Close logs any buffered partial line
+func (w *levelWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.buffer.Len() > 0 {
+		logAt(w.level, strings.TrimRight(w.buffer.String(), "\r"))
+		w.buffer.Reset()
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/prompt
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package prompt provides a uniform api for interactive prompts and confirmations on linux/windows
+package prompt
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/knowntraveler/gogo/term"
+	auroraPackage "github.com/logrusorgru/aurora"
+)
+
+// ErrNonInteractive is returned by prompts that have no default answer
+// when non-interactive mode is enabled
+var ErrNonInteractive = errors.New("Prompt requires input but non-interactive mode is enabled")
+
+var aurora auroraPackage.Aurora
+
+// Flag to Enable Non-Interactive Mode (e.g. CI)
+var nonInteractiveEnabled bool
+
+// Flag to Answer Yes to Confirmations
+var assumeYesEnabled bool
+
+// Input and Output for Prompts (replaceable in tests)
+var (
+	stdin            = bufio.NewReader(os.Stdin)
+	stdout io.Writer = os.Stdout
+)
+
+func init() {
+	aurora = auroraPackage.NewAurora(term.SupportsColor())
+}
+
+// EnableNonInteractive turns on non-interactive mode, where prompts are not
+// shown and default answers are used instead (e.g. --non-interactive in CI)
+func EnableNonInteractive() {
+	nonInteractiveEnabled = true
+}
+
+// EnableAssumeYes answers yes to every Confirm() without prompting (e.g. --yes)
+func EnableAssumeYes() {
+	assumeYesEnabled = true
+}
+
+// Confirm asks a yes/no question and returns true when the answer is yes.
+// Returns false in non-interactive mode unless EnableAssumeYes() is set.
+func Confirm(question string) bool {
+	if assumeYesEnabled {
+		return true
+	}
+	if nonInteractiveEnabled {
+		return false
+	}
+
+	for {
+		ask(question, "[y/N]")
+		answer, err := readLine()
+		if err != nil {
+			return false
+		}
+
+		switch strings.ToLower(answer) {
+		case "y", "yes":
+			return true
+		case "", "n", "no":
+			return false
+		}
+	}
+}
+
+// Input asks for a line of text, returning defaultValue when the answer
+// is empty or in non-interactive mode
+func Input(question string, defaultValue string) string {
+	if nonInteractiveEnabled {
+		return defaultValue
+	}
+
+	hint := ""
+	if defaultValue != "" {
+		hint = "[" + defaultValue + "]"
+	}
+	ask(question, hint)
+
+	answer, err := readLine()
+	if err != nil || answer == "" {
+		return defaultValue
+	}
+	return answer
+}
+
+// Select asks the user to choose one of the options and returns its index.
+// Returns ErrNonInteractive in non-interactive mode.
+func Select(question string, options []string) (int, error) {
+	if len(options) == 0 {
+		return -1, fmt.Errorf("Failed to select. At least one option is required")
+	}
+	if nonInteractiveEnabled {
+		return -1, ErrNonInteractive
+	}
+
+	// Print Options
+	fmt.Fprintln(stdout, aurora.BrightCyan(question))
+	for i, option := range options {
+		fmt.Fprintf(stdout, "  %v) %v\n", aurora.BrightCyan(i+1), option)
+	}
+
+	for {
+		ask("Enter a number", fmt.Sprintf("[1-%v]", len(options)))
+		answer, err := readLine()
+		if err != nil {
+			return -1, err
+		}
+
+		// Validate Answer
+		choice, err := strconv.Atoi(answer)
+		if err == nil && choice >= 1 && choice <= len(options) {
+			return choice - 1, nil
+		}
+	}
+}
+
+// Password asks for a secret without echoing the input.
+// Returns ErrNonInteractive in non-interactive mode.
+func Password(question string) (string, error) {
+	if nonInteractiveEnabled {
+		return "", ErrNonInteractive
+	}
+
+	ask(question, "")
+
+	// Read Line when Stdin is not a Terminal (e.g. piped input)
+	if stdin.Buffered() > 0 || !term.IsTerminal(os.Stdin) {
+		return readRawLine()
+	}
+
+	password, err := term.ReadPassword(os.Stdin)
+	fmt.Fprintln(stdout)
+	if err != nil {
+		return "", err
+	}
+	return string(password), nil
+}
+
+// ask prints a question followed by an optional hint (e.g. [y/N])
+func ask(question string, hint string) {
+	if hint != "" {
+		fmt.Fprintf(stdout, "%v %v: ", aurora.BrightCyan(question), aurora.Gray(12, hint))
+		return
+	}
+	fmt.Fprintf(stdout, "%v: ", aurora.BrightCyan(question))
+}
+
+// readLine reads a line of input without surrounding whitespace
+func readLine() (string, error) {
+	line, err := readRawLine()
+	return strings.TrimSpace(line), err
+}
+
+// readRawLine reads a line of input without the trailing newline (spaces are kept, e.g. for passwords)
+func readRawLine() (string, error) {
+	line, err := stdin.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,102 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/prompt
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package prompt
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	auroraPackage "github.com/logrusorgru/aurora"
+	"github.com/stretchr/testify/assert"
+)
+
+// TEST HELPER FUNCTIONS
+
+// withInput() is a Helper Function for answering prompts from a string
+func withInput(input string, f func()) string {
+	var str bytes.Buffer
+
+	// Set Prompt Input and Output (without colors)
+	aurora = auroraPackage.NewAurora(false)
+	stdin = bufio.NewReader(strings.NewReader(input))
+	stdout = &str
+
+	// Run the Prompt Function
+	f()
+
+	// Return the captured Prompt Output
+	return str.String()
+}
+
+// TestConfirm is a unit test for prompt.Confirm()
+func TestConfirm(t *testing.T) {
+	var answer bool
+	output := withInput("maybe\nyes\n", func() {
+		answer = Confirm("Delete files?")
+	})
+	// Assert Unit Test
+	assert.True(t, answer)
+	assert.Equal(t, "Delete files? [y/N]: Delete files? [y/N]: ", output)
+}
+
+// TestInput is a unit test for prompt.Input()
+func TestInput(t *testing.T) {
+	var answer string
+	withInput("\n", func() {
+		answer = Input("Project name", "gogo")
+	})
+	// Assert Unit Test
+	assert.Equal(t, "gogo", answer)
+}
+
+// TestSelect is a unit test for prompt.Select()
+func TestSelect(t *testing.T) {
+	var choice int
+	output := withInput("3\n2\n", func() {
+		choice, _ = Select("Environment", []string{"dev", "prod"})
+	})
+	// Assert Unit Test
+	assert.Equal(t, 1, choice)
+	assert.Equal(t, "Environment\n  1) dev\n  2) prod\nEnter a number [1-2]: Enter a number [1-2]: ", output)
+}
+
+// TestPassword is a unit test for prompt.Password() reading piped input
+func TestPassword(t *testing.T) {
+	var answers [2]string
+	output := withInput("  p@ss word \r\nsecret", func() {
+		answers[0], _ = Password("Token")
+		answers[1], _ = Password("Token")
+	})
+	// Assert Unit Test
+	assert.Equal(t, [2]string{"  p@ss word ", "secret"}, answers)
+	assert.Equal(t, "Token: Token: ", output)
+
+	// Assert Empty Input
+	withInput("", func() {
+		_, err := Password("Token")
+		assert.Error(t, err)
+	})
+}
+
+// TestNonInteractive is a unit test for prompt.EnableNonInteractive()
+func TestNonInteractive(t *testing.T) {
+	EnableNonInteractive()
+	defer func() { nonInteractiveEnabled = false }()
+
+	output := withInput("", func() {
+		assert.False(t, Confirm("Delete files?"))
+		assert.Equal(t, "gogo", Input("Project name", "gogo"))
+		_, err := Password("Token")
+		assert.Equal(t, ErrNonInteractive, err)
+	})
+	// Assert Unit Test
+	assert.Equal(t, "", output)
+}
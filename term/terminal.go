@@ -0,0 +1,126 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/term
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package term provides a uniform api for detecting terminal capabilities on linux/windows
+package term
+
+import (
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// Width used when the terminal width cannot be detected
+const defaultWidth = 80
+
+// Terminal describes the capabilities of the terminal attached to Stdout
+type Terminal struct {
+	IsTerminal bool // Stdout is attached to a terminal
+	Width      int  // Width of the terminal in columns
+	Height     int  // Height of the terminal in rows (0 when unknown)
+	Color      bool // Terminal supports ANSI colors
+	Unicode    bool // Terminal can render Unicode glyphs
+}
+
+// DetectTerminal detects the capabilities of the terminal attached to Stdout
+func DetectTerminal() Terminal {
+	t := Terminal{
+		IsTerminal: IsTerminal(os.Stdout),
+		Width:      Width(),
+		Color:      SupportsColor(),
+		Unicode:    SupportsUnicode(),
+	}
+	if t.IsTerminal {
+		_, t.Height, _ = term.GetSize(int(os.Stdout.Fd()))
+	}
+	return t
+}
+
+// IsTerminal simply checks if a file (e.g. os.Stdout) is attached to a terminal
+func IsTerminal(file *os.File) bool {
+	return term.IsTerminal(int(file.Fd()))
+}
+
+// ReadPassword reads a line from a terminal without echoing the input
+func ReadPassword(file *os.File) ([]byte, error) {
+	return term.ReadPassword(int(file.Fd()))
+}
+
+// Width returns the width in columns of the terminal attached to Stdout.
+// Falls back to $COLUMNS and then 80 columns when the width cannot be detected.
+func Width() int {
+	// Check Terminal Size
+	width, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err == nil && width > 0 {
+		return width
+	}
+
+	// Check COLUMNS Environment Variable
+	width, err = strconv.Atoi(os.Getenv("COLUMNS"))
+	if err == nil && width > 0 {
+		return width
+	}
+
+	return defaultWidth
+}
+
+// SupportsColor checks if ANSI colors should be written to Stdout.
+// NO_COLOR (https://no-color.org) disables colors and FORCE_COLOR or
+// CLICOLOR_FORCE enables colors even when Stdout is not a terminal.
+// On Windows, ANSI escape processing is enabled on the console.
+func SupportsColor() bool {
+	// Check NO_COLOR
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+
+	// Check FORCE_COLOR / CLICOLOR_FORCE
+	if isSet("FORCE_COLOR") || isSet("CLICOLOR_FORCE") {
+		return true
+	}
+
+	// Check Terminal
+	if !IsTerminal(os.Stdout) || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+
+	return EnableVirtualTerminal(os.Stdout) == nil
+}
+
+// SupportsUnicode makes a best effort guess whether the terminal
+// can render Unicode glyphs based on the environment
+func SupportsUnicode() bool {
+	// Windows Terminal and VS Code render Unicode, legacy conhost does not
+	if runtime.GOOS == "windows" {
+		return os.Getenv("WT_SESSION") != "" || os.Getenv("TERM_PROGRAM") == "vscode"
+	}
+
+	// The Linux Virtual Console has a limited glyph set
+	if os.Getenv("TERM") == "linux" {
+		return false
+	}
+
+	// Check Locale (first non-empty value wins, matching setlocale)
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if value := os.Getenv(name); value != "" {
+			value = strings.ToUpper(value)
+			return strings.Contains(value, "UTF-8") || strings.Contains(value, "UTF8")
+		}
+	}
+
+	return false
+}
+
+// isSet checks if an environment variable is set to a value other than "" or "0"
+func isSet(name string) bool {
+	value := os.Getenv(name)
+	return value != "" && value != "0"
+}
@@ -0,0 +1,22 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/term
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !windows
+// +build !windows
+
+package term
+
+import (
+	"os"
+)
+
+// EnableVirtualTerminal is a no-op on platforms where terminals
+// process ANSI escape sequences natively
+func EnableVirtualTerminal(file *os.File) error {
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/term
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package term
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TEST HELPER FUNCTIONS
+
+// withoutTerminal() is a Helper Function for replacing Stdout with a regular file
+func withoutTerminal(t *testing.T) {
+	file, err := os.Create(filepath.Join(t.TempDir(), "stdout"))
+	assert.NoError(t, err)
+	stdout := os.Stdout
+	os.Stdout = file
+	t.Cleanup(func() {
+		os.Stdout = stdout
+		file.Close()
+	})
+}
+
+// unsetEnv() is a Helper Function for unsetting environment variables until the test ends
+func unsetEnv(t *testing.T, names ...string) {
+	for _, name := range names {
+		t.Setenv(name, "")
+		os.Unsetenv(name)
+	}
+}
+
+// TestIsTerminal is a unit test for term.IsTerminal()
+func TestIsTerminal(t *testing.T) {
+	withoutTerminal(t)
+
+	// Assert Unit Test
+	assert.False(t, IsTerminal(os.Stdout))
+}
+
+// TestWidth is a unit test for term.Width()
+func TestWidth(t *testing.T) {
+	withoutTerminal(t)
+
+	// Assert Unit Test
+	t.Setenv("COLUMNS", "120")
+	assert.Equal(t, 120, Width())
+
+	// Assert Fallback to 80 Columns
+	for _, columns := range []string{"", "wide", "0", "-5"} {
+		t.Setenv("COLUMNS", columns)
+		assert.Equal(t, defaultWidth, Width(), columns)
+	}
+}
+
+// TestSupportsColor is a unit test for term.SupportsColor()
+func TestSupportsColor(t *testing.T) {
+	withoutTerminal(t)
+	unsetEnv(t, "NO_COLOR", "FORCE_COLOR", "CLICOLOR_FORCE")
+
+	// Assert Unit Test
+	assert.False(t, SupportsColor())
+
+	// Assert FORCE_COLOR and CLICOLOR_FORCE enable Colors
+	t.Setenv("FORCE_COLOR", "0")
+	assert.False(t, SupportsColor())
+	t.Setenv("FORCE_COLOR", "1")
+	assert.True(t, SupportsColor())
+	unsetEnv(t, "FORCE_COLOR")
+	t.Setenv("CLICOLOR_FORCE", "1")
+	assert.True(t, SupportsColor())
+
+	// Assert NO_COLOR disables Colors (even when empty or forced)
+	t.Setenv("NO_COLOR", "")
+	assert.False(t, SupportsColor())
+}
+
+// TestSupportsUnicode is a unit test for term.SupportsUnicode()
+func TestSupportsUnicode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unicode support is detected from the terminal program on windows")
+	}
+	unsetEnv(t, "LC_ALL", "LC_CTYPE", "LANG")
+	t.Setenv("TERM", "xterm-256color")
+
+	// Assert Unit Test
+	assert.False(t, SupportsUnicode())
+	t.Setenv("LANG", "en_US.UTF-8")
+	assert.True(t, SupportsUnicode())
+	t.Setenv("LC_ALL", "C")
+	assert.False(t, SupportsUnicode())
+
+	// Assert the Linux Virtual Console
+	unsetEnv(t, "LC_ALL")
+	t.Setenv("TERM", "linux")
+	assert.False(t, SupportsUnicode())
+}
+
+// TestDetectTerminal is a unit test for term.DetectTerminal()
+func TestDetectTerminal(t *testing.T) {
+	withoutTerminal(t)
+	unsetEnv(t, "NO_COLOR", "FORCE_COLOR", "CLICOLOR_FORCE")
+	t.Setenv("COLUMNS", "100")
+
+	// Assert Unit Test
+	terminal := DetectTerminal()
+	assert.False(t, terminal.IsTerminal)
+	assert.Equal(t, 100, terminal.Width)
+	assert.Equal(t, 0, terminal.Height)
+	assert.False(t, terminal.Color)
+}
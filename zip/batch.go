@@ -0,0 +1,100 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// UnarchiveAll Function for Extracting many Archive Files (.zip, tar archives, .7z or .rar)
+// in parallel, e.g. to restore per-service bundles at once. Each archive is extracted to a
+// directory below targetRoot named after the archive without its extension (bundles/api.tar.gz
+// to targetRoot/api), running at most concurrency extractions at a time (values below 1
+// extract one archive at a time). A failed archive doesn't stop the others, every failure
+// is returned joined into one error. The UnarchiveOptions apply to every archive, their
+// callbacks are called concurrently and DryRun is not supported.
+func UnarchiveAll(sources []string, targetRoot string, concurrency int, options ...UnarchiveOptions) error {
+
+	// Validate Target Parameter
+	if targetRoot == "" {
+		return fmt.Errorf("The 'targetRoot' parameter was empty. A target is required to extract Archives")
+	}
+
+	// Apply Unarchive Options
+	unarchiveOptions := UnarchiveOptions{}
+	if len(options) > 0 {
+		unarchiveOptions = options[0]
+	}
+	if unarchiveOptions.DryRun != nil {
+		return fmt.Errorf("DryRun is not supported by UnarchiveAll, preview each archive with Unarchive")
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	// Set Target Directories (archives must not share a target directory)
+	targets := map[string]string{}
+	for _, source := range sources {
+		if source == "" {
+			return fmt.Errorf("The 'sources' parameter contains an empty source. A source is required to extract an Archive")
+		}
+		name := archiveName(source)
+		if other, ok := targets[name]; ok {
+			return fmt.Errorf("Archives '%v' and '%v' both extract to '%v'", other, source, filepath.Join(targetRoot, name))
+		}
+		targets[name] = source
+	}
+
+	var mutex sync.Mutex
+	var errs []error
+
+	// Extract Archives Concurrently
+	queue := make(chan string)
+	var wait sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			for source := range queue {
+				err := Unarchive(source, filepath.Join(targetRoot, archiveName(source)), unarchiveOptions)
+				if err != nil {
+					mutex.Lock()
+					errs = append(errs, fmt.Errorf("Failed to extract '%v': %w", source, err))
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+	for _, source := range sources {
+		queue <- source
+	}
+	close(queue)
+	wait.Wait()
+
+	return errors.Join(errs...)
+}
+
+// archiveName returns the file name of an archive without its archive extension (and
+// without the part number of a split archive)
+func archiveName(source string) string {
+	name := filepath.Base(trimPart(source))
+	lower := strings.ToLower(name)
+	if compressor, ok := CompressorFor(name); ok {
+		for _, extension := range compressor.Extensions() {
+			if strings.HasSuffix(lower, strings.ToLower(extension)) {
+				return name[:len(name)-len(extension)]
+			}
+		}
+	}
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
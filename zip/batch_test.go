@@ -0,0 +1,79 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestArchiveName is a unit test for zip.archiveName()
+func TestArchiveName(t *testing.T) {
+	for source, expected := range map[string]string{
+		"bundles/api.zip":       "api",
+		"bundles/web.tar.gz":    "web",
+		"bundles/db.TGZ":        "db",
+		"bundles/queue.tar.zst": "queue",
+		"bundles/big.zip.001":   "big",
+		"bundles/v1.2.7z":       "v1.2",
+	} {
+		// Assert Unit Test
+		assert.Equal(t, expected, archiveName(source), source)
+	}
+}
+
+// TestUnarchiveAll is a unit test for zip.UnarchiveAll()
+func TestUnarchiveAll(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"f.txt": "hello"})
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "bundles"), 0755))
+	var sources []string
+	for _, name := range []string{"api.zip", "web.tar.gz", "db.tar", "queue.tar.zst", "cache.tgz"} {
+		archive := filepath.Join(dir, "bundles", name)
+		assert.NoError(t, Archive(source, archive))
+		sources = append(sources, archive)
+	}
+
+	// Assert Unit Test
+	target := filepath.Join(dir, "out")
+	assert.NoError(t, UnarchiveAll(sources, target, 3))
+	for _, name := range []string{"api", "web", "db", "queue", "cache"} {
+		data, err := os.ReadFile(filepath.Join(target, name, "f.txt"))
+		assert.NoError(t, err, name)
+		assert.Equal(t, "hello", string(data), name)
+	}
+}
+
+// TestUnarchiveAllErrors is a unit test for zip.UnarchiveAll() joining the errors of failed archives
+func TestUnarchiveAllErrors(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"f.txt": "hello"})
+	writeTestTree(t, filepath.Join(dir, "bundles"), map[string]string{"bad.zip": "nope", "worse.tar.gz": "nope"})
+	archive := filepath.Join(dir, "bundles", "web.tar.gz")
+	assert.NoError(t, Archive(source, archive))
+	sources := []string{filepath.Join(dir, "bundles", "bad.zip"), archive, filepath.Join(dir, "bundles", "worse.tar.gz")}
+
+	// Assert Unit Test
+	target := filepath.Join(dir, "out")
+	err := UnarchiveAll(sources, target, 0)
+	assert.ErrorContains(t, err, "Failed to extract '"+sources[0]+"'")
+	assert.ErrorContains(t, err, "Failed to extract '"+sources[2]+"'")
+	assert.FileExists(t, filepath.Join(target, "web", "f.txt"))
+
+	// Assert Invalid Parameters
+	assert.ErrorContains(t, UnarchiveAll([]string{"a/x.zip", "b/x.tar"}, target, 2), "both extract")
+	assert.ErrorContains(t, UnarchiveAll([]string{archive, ""}, target, 2), "empty source")
+	assert.ErrorContains(t, UnarchiveAll([]string{archive}, "", 1), "The 'targetRoot' parameter was empty")
+	assert.ErrorContains(t, UnarchiveAll([]string{archive}, target, 1, UnarchiveOptions{DryRun: &Plan{}}), "DryRun is not supported")
+}
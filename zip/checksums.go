@@ -0,0 +1,177 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/knowntraveler/gogo/fs"
+)
+
+// entryChecksum is the checksum of a file entry of an archive
+type entryChecksum struct {
+	name     string
+	checksum string
+}
+
+// WriteChecksums Function for Writing a checksum manifest of the file entries of an Archive
+// File (.zip, a tar archive, .7z or .rar) in the format of sha256sum ("<hex>  <entry name>",
+// one line per file in archive order), so release pipelines can publish a verifiable list
+// of the archive contents. Directories and symbolic links are not listed, tar hard links
+// are listed with the checksum of the linked file. The manifest is written to
+// ChecksumOptions.Target (default the source path with a ".SHA256SUMS" suffix, named
+// after the algorithm) and its path is returned. Check an archive against the manifest
+// with Verify and VerifyOptions.Manifest.
+func WriteChecksums(source string, algorithm fs.Algorithm, options ...ChecksumOptions) (string, error) {
+
+	// Validate Source Parameter
+	if source == "" {
+		return "", fmt.Errorf("The 'source' parameter was empty. A source is required to write Checksums")
+	}
+
+	// Apply Checksum Options
+	checksumOptions := ChecksumOptions{}
+	if len(options) > 0 {
+		checksumOptions = options[0]
+	}
+	target := checksumOptions.Target
+	if target == "" {
+		target = source + "." + strings.ToUpper(string(algorithm)) + "SUMS"
+	}
+
+	// Hash File Entries
+	checksums, err := entryChecksums(source, algorithm, checksumOptions.Password)
+	if err != nil {
+		return "", err
+	}
+
+	// Write Manifest
+	var manifest strings.Builder
+	for _, entry := range checksums {
+		if strings.ContainsAny(entry.name, "\r\n") {
+			return "", fmt.Errorf("Archive entry '%v' can't be listed in a checksum manifest", entry.name)
+		}
+		fmt.Fprintf(&manifest, "%v  %v\n", entry.checksum, entry.name)
+	}
+	err = os.WriteFile(target, []byte(manifest.String()), 0644)
+	if err != nil {
+		return "", err
+	}
+
+	return target, nil
+}
+
+// entryChecksums returns the checksums of the file entries of an archive in archive
+// order (the contents are decrypted with the password if set)
+func entryChecksums(source string, algorithm fs.Algorithm, password string) ([]entryChecksum, error) {
+	var checksums []entryChecksum
+	sums := map[string]string{}
+	err := readEntries(context.Background(), source, UnarchiveOptions{Password: password}, func(entry archiveEntry) error {
+		var checksum string
+		switch {
+		case entry.hardLink != "":
+			// Hard Links have the Checksum of the Linked File
+			linked, ok := sums[entry.hardLink]
+			if !ok {
+				return fmt.Errorf("Hard link '%v' points to '%v', which is not an earlier file", entry.Name, entry.hardLink)
+			}
+			checksum = linked
+		case entry.contents != nil:
+			var err error
+			checksum, err = fs.ChecksumReader(entry.contents, algorithm)
+			if err != nil {
+				return err
+			}
+		default:
+			// Directories, Links and Special Files are not listed
+			return nil
+		}
+		sums[entry.Name] = checksum
+		checksums = append(checksums, entryChecksum{entry.Name, checksum})
+		return nil
+	})
+	return checksums, err
+}
+
+// verifyManifest checks the file entries of an archive against a checksum manifest,
+// returning every mismatched, missing and unlisted entry joined into one error
+func verifyManifest(source string, manifest string, password string) error {
+
+	// Read Manifest
+	file, err := os.Open(manifest)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("File '%v' doesn't exist", manifest)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to open file '%v': %w", manifest, err)
+	}
+	defer file.Close()
+
+	var names []string
+	expected := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, checksum, ok := parseChecksumLine(line)
+		if !ok {
+			return fmt.Errorf("Checksum file '%v' has an invalid line '%v'", manifest, line)
+		}
+		names = append(names, name)
+		expected[name] = checksum
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("Checksum file '%v' lists no files", manifest)
+	}
+
+	// Detect Checksum Algorithm (from the first listed file)
+	algorithm, _ := fs.DetectAlgorithm(expected[names[0]])
+	if algorithm == "" {
+		return fmt.Errorf("Checksum '%v' has an unknown algorithm", expected[names[0]])
+	}
+
+	// Hash File Entries
+	checksums, err := entryChecksums(source, algorithm, password)
+	if err != nil {
+		return err
+	}
+
+	// Compare Checksums
+	var errs []error
+	found := map[string]bool{}
+	for _, entry := range checksums {
+		found[entry.name] = true
+		checksum, ok := expected[entry.name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("Archive entry '%v' is not listed in '%v'", entry.name, manifest))
+			continue
+		}
+		_, sum := fs.DetectAlgorithm(checksum)
+		if !strings.EqualFold(sum, entry.checksum) {
+			errs = append(errs, fmt.Errorf("Archive entry '%v' %v checksum mismatch (expected %v, got %v)", entry.name, algorithm, sum, entry.checksum))
+		}
+	}
+	for _, name := range names {
+		if !found[name] {
+			errs = append(errs, fmt.Errorf("File '%v' listed in '%v' is missing from '%v'", name, manifest, source))
+		}
+	}
+
+	return errors.Join(errs...)
+}
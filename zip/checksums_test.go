@@ -0,0 +1,90 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/knowntraveler/gogo/fs"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWriteChecksums is a unit test for zip.WriteChecksums()
+func TestWriteChecksums(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a/f.txt": "hello", "dup.txt": "hello", "g.txt": "other"})
+	os.Symlink("g.txt", filepath.Join(source, "l"))
+
+	for _, name := range []string{"release.zip", "release.tar.gz"} {
+		archive := filepath.Join(dir, name)
+		// Duplicates are tar hard links, which are listed with the checksum of the linked file
+		assert.NoError(t, Archive(source, archive, ArchiveOptions{Deduplicate: name == "release.tar.gz"}))
+
+		// Assert Unit Test
+		manifest, err := WriteChecksums(archive, fs.SHA256)
+		assert.NoError(t, err)
+		assert.Equal(t, archive+".SHA256SUMS", manifest)
+		data, err := os.ReadFile(manifest)
+		assert.NoError(t, err)
+		assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824  a/f.txt\n"+
+			"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824  dup.txt\n"+
+			"d9298a10d1b0735837dc4bd85dac641b0f3cef27a47e5d53a54f2f3f5b2fcffa  g.txt\n", string(data), name)
+		assert.NoError(t, Verify(archive, VerifyOptions{Manifest: manifest}), name)
+
+		// Assert a Target and other Algorithms
+		target := filepath.Join(dir, "MD5SUMS")
+		manifest, err = WriteChecksums(archive, fs.MD5, ChecksumOptions{Target: target})
+		assert.NoError(t, err)
+		assert.Equal(t, target, manifest)
+		assert.NoError(t, Verify(archive, VerifyOptions{Manifest: manifest}), name)
+	}
+
+	// Assert BSD Format Manifests
+	manifest := filepath.Join(dir, "BSD")
+	writeTestTree(t, dir, map[string]string{"BSD": "# release\n" +
+		"SHA256 (a/f.txt) = 2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824\n" +
+		"SHA256 (dup.txt) = 2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824\n" +
+		"SHA256 (g.txt) = d9298a10d1b0735837dc4bd85dac641b0f3cef27a47e5d53a54f2f3f5b2fcffa\n"})
+	assert.NoError(t, Verify(filepath.Join(dir, "release.zip"), VerifyOptions{Manifest: manifest}))
+}
+
+// TestWriteChecksumsErrors is a unit test for zip.WriteChecksums() and zip.Verify() with a mismatched manifest
+func TestWriteChecksumsErrors(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "hello", "b.txt": "other"})
+	archive := filepath.Join(dir, "release.zip")
+	assert.NoError(t, Archive(source, archive, ArchiveOptions{Password: "secret"}))
+
+	// Assert Unit Test
+	_, err := WriteChecksums(archive, fs.SHA256)
+	assert.Error(t, err)
+	assert.NoFileExists(t, archive+".SHA256SUMS")
+	manifest, err := WriteChecksums(archive, fs.SHA256, ChecksumOptions{Password: "secret"})
+	assert.NoError(t, err)
+	_, err = WriteChecksums(archive, "crc", ChecksumOptions{Password: "secret"})
+	assert.Error(t, err)
+	_, err = WriteChecksums("", fs.SHA256)
+	assert.ErrorContains(t, err, "The 'source' parameter was empty")
+
+	// Assert Mismatched, Missing and Unlisted Entries
+	writeTestTree(t, dir, map[string]string{filepath.Base(manifest): "" +
+		"0000000000000000000000000000000000000000000000000000000000000000  a.txt\n" +
+		"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824  missing.txt\n"})
+	err = Verify(archive, VerifyOptions{Password: "secret", Manifest: manifest})
+	assert.ErrorContains(t, err, "Archive entry 'a.txt' sha256 checksum mismatch")
+	assert.ErrorContains(t, err, "File 'missing.txt' listed in")
+	assert.ErrorContains(t, err, "Archive entry 'b.txt' is not listed")
+	writeTestTree(t, dir, map[string]string{"INVALID": "not a manifest\n"})
+	assert.ErrorContains(t, Verify(archive, VerifyOptions{Password: "secret", Manifest: filepath.Join(dir, "INVALID")}), "unknown algorithm")
+	assert.ErrorContains(t, Verify(archive, VerifyOptions{Password: "secret", Manifest: filepath.Join(dir, "missing")}), "doesn't exist")
+}
@@ -0,0 +1,181 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+// ErrCompressionNotSupported is returned by Compressor.Writer for formats gogo can only decompress
+var ErrCompressionNotSupported = errors.New("compression is not supported for this format")
+
+// Compressor is a stream compression format for tar archives (e.g. .tar.gz or
+// .tar.zst), Archive and Unarchive choose the Compressor by the file extension
+type Compressor interface {
+	// Name returns the name of the format (e.g. "zstd")
+	Name() string
+
+	// Extensions returns the file extensions of tar archives compressed with
+	// the format (e.g. ".tar.zst" and ".tzst")
+	Extensions() []string
+
+	// Reader returns a reader decompressing r
+	Reader(r io.Reader) (io.ReadCloser, error)
+
+	// Writer returns a writer compressing to w, or ErrCompressionNotSupported
+	Writer(w io.Writer) (io.WriteCloser, error)
+}
+
+// LevelCompressor is a Compressor supporting compression levels, tar archives are
+// compressed with WriterLevel when ArchiveOptions.Level is set
+type LevelCompressor interface {
+	Compressor
+
+	// WriterLevel returns a writer compressing to w at a CompressionLevel
+	WriterLevel(w io.Writer, level CompressionLevel) (io.WriteCloser, error)
+}
+
+// Registered Compressors (gzip, zstd, bzip2 and xz are built in)
+var (
+	compressorsMutex sync.RWMutex
+	compressors      = []Compressor{gzipCompressor{}, zstdCompressor{}, bzip2Compressor{}, xzCompressor{}}
+)
+
+// RegisterCompressor adds a Compressor, replacing a registered Compressor with the same name
+func RegisterCompressor(compressor Compressor) {
+	compressorsMutex.Lock()
+	defer compressorsMutex.Unlock()
+
+	for i, registered := range compressors {
+		if registered.Name() == compressor.Name() {
+			compressors[i] = compressor
+			return
+		}
+	}
+	compressors = append(compressors, compressor)
+}
+
+// CompressorFor returns the Compressor registered for the extension of a file
+// name (e.g. "release.tar.zst"), comparing extensions case-insensitively
+func CompressorFor(name string) (Compressor, bool) {
+	compressorsMutex.RLock()
+	defer compressorsMutex.RUnlock()
+
+	name = strings.ToLower(name)
+	for _, compressor := range compressors {
+		for _, extension := range compressor.Extensions() {
+			if strings.HasSuffix(name, strings.ToLower(extension)) {
+				return compressor, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// tarFormat reports whether a file name is a tar archive (or the first part of a
+// split tar archive), returning its Compressor (nil for an uncompressed .tar)
+func tarFormat(name string) (Compressor, bool) {
+	name = trimPart(name)
+	if strings.HasSuffix(strings.ToLower(name), ".tar") {
+		return nil, true
+	}
+	return CompressorFor(name)
+}
+
+// compressWriter returns a writer compressing to w with a Compressor, at the
+// CompressionLevel if the Compressor is a LevelCompressor
+func compressWriter(compressor Compressor, w io.Writer, level CompressionLevel) (io.WriteCloser, error) {
+	if leveled, ok := compressor.(LevelCompressor); ok && level != DefaultCompression {
+		return leveled.WriterLevel(w, level)
+	}
+	return compressor.Writer(w)
+}
+
+// gzipCompressor is the gzip Compressor (.tar.gz)
+type gzipCompressor struct{}
+
+func (gzipCompressor) Name() string { return "gzip" }
+
+func (gzipCompressor) Extensions() []string { return []string{".tar.gz", ".tgz"} }
+
+func (gzipCompressor) Reader(r io.Reader) (io.ReadCloser, error) { return gzip.NewReader(r) }
+
+func (gzipCompressor) Writer(w io.Writer) (io.WriteCloser, error) { return gzip.NewWriter(w), nil }
+
+func (gzipCompressor) WriterLevel(w io.Writer, level CompressionLevel) (io.WriteCloser, error) {
+	return gzip.NewWriterLevel(w, level.flate())
+}
+
+// zstdCompressor is the Zstandard Compressor (.tar.zst)
+type zstdCompressor struct{}
+
+func (zstdCompressor) Name() string { return "zstd" }
+
+func (zstdCompressor) Extensions() []string { return []string{".tar.zst", ".tzst"} }
+
+func (zstdCompressor) Reader(r io.Reader) (io.ReadCloser, error) {
+	decoder, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return decoder.IOReadCloser(), nil
+}
+
+func (zstdCompressor) Writer(w io.Writer) (io.WriteCloser, error) { return zstd.NewWriter(w) }
+
+func (zstdCompressor) WriterLevel(w io.Writer, level CompressionLevel) (io.WriteCloser, error) {
+	speed := zstd.SpeedDefault
+	switch level {
+	case Store, Fastest:
+		speed = zstd.SpeedFastest
+	case BestCompression:
+		speed = zstd.SpeedBestCompression
+	}
+	return zstd.NewWriter(w, zstd.WithEncoderLevel(speed))
+}
+
+// bzip2Compressor is the bzip2 Compressor (.tar.bz2), bzip2 archives can only be decompressed
+type bzip2Compressor struct{}
+
+func (bzip2Compressor) Name() string { return "bzip2" }
+
+func (bzip2Compressor) Extensions() []string { return []string{".tar.bz2", ".tbz2", ".tbz"} }
+
+func (bzip2Compressor) Reader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(bzip2.NewReader(r)), nil
+}
+
+func (bzip2Compressor) Writer(w io.Writer) (io.WriteCloser, error) {
+	return nil, ErrCompressionNotSupported
+}
+
+// xzCompressor is the xz Compressor (.tar.xz)
+type xzCompressor struct{}
+
+func (xzCompressor) Name() string { return "xz" }
+
+func (xzCompressor) Extensions() []string { return []string{".tar.xz", ".txz"} }
+
+func (xzCompressor) Reader(r io.Reader) (io.ReadCloser, error) {
+	reader, err := xz.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(reader), nil
+}
+
+func (xzCompressor) Writer(w io.Writer) (io.WriteCloser, error) { return xz.NewWriter(w) }
@@ -0,0 +1,152 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCompressor is a Compressor "compressing" by prefixing a magic header (TEST)
+type testCompressor struct{}
+
+func (testCompressor) Name() string { return "test" }
+
+func (testCompressor) Extensions() []string { return []string{".tar.test"} }
+
+func (testCompressor) Reader(r io.Reader) (io.ReadCloser, error) {
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != "TEST" {
+		return nil, errors.New("not a test stream")
+	}
+	return io.NopCloser(r), nil
+}
+
+func (testCompressor) Writer(w io.Writer) (io.WriteCloser, error) {
+	if _, err := w.Write([]byte("TEST")); err != nil {
+		return nil, err
+	}
+	return nopWriteCloser{w}, nil
+}
+
+// nopWriteCloser is an io.WriteCloser whose Close does nothing
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// TestCompressorFor is a unit test for zip.CompressorFor()
+func TestCompressorFor(t *testing.T) {
+	for name, expected := range map[string]string{
+		"a.tar.gz":         "gzip",
+		"a.tgz":            "gzip",
+		"Release.TAR.ZST":  "zstd",
+		"a.tzst":           "zstd",
+		"a.tar.bz2":        "bzip2",
+		"a.tbz":            "bzip2",
+		"a.tar.xz":         "xz",
+		"a.txz":            "xz",
+		"release.tar.gz.1": "",
+		"a.zip":            "",
+	} {
+		compressor, ok := CompressorFor(name)
+
+		// Assert Unit Test
+		assert.Equal(t, expected != "", ok, name)
+		if ok {
+			assert.Equal(t, expected, compressor.Name(), name)
+		}
+	}
+}
+
+// TestTarCompressors is a unit test for zip.Archive() and zip.Unarchive() with compressed tar archives
+func TestTarCompressors(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"b.txt": "hello", "sub/a.txt": ""})
+	assert.NoError(t, os.Chmod(filepath.Join(source, "b.txt"), 0755))
+
+	for _, ext := range []string{".tar", ".tar.gz", ".tgz", ".tar.zst", ".tar.xz"} {
+		archive := filepath.Join(dir, "out"+ext)
+		target := filepath.Join(dir, "x"+ext)
+		assert.NoError(t, Archive(source, archive), ext)
+
+		// Assert Unit Test
+		assert.NoError(t, Unarchive(archive, target), ext)
+		data, err := os.ReadFile(filepath.Join(target, "b.txt"))
+		assert.NoError(t, err, ext)
+		assert.Equal(t, "hello", string(data), ext)
+		assert.FileExists(t, filepath.Join(target, "sub", "a.txt"), ext)
+		if info, err := os.Stat(filepath.Join(target, "b.txt")); err == nil && runtime.GOOS != "windows" {
+			assert.Equal(t, os.FileMode(0755), info.Mode().Perm(), ext)
+		}
+	}
+
+	// Assert a bzip2 Archive written by bsdtar is Extracted
+	target := filepath.Join(dir, "bzip2")
+	assert.NoError(t, Unarchive(filepath.Join("testdata", "bzip2.tar.bz2"), target))
+	data, err := os.ReadFile(filepath.Join(target, "readme.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "compressed by bzip2\n", string(data))
+	data, err = os.ReadFile(filepath.Join(target, "sub", "nested.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "nested\n", string(data))
+}
+
+// TestTarCompressorErrors is a unit test for zip.Archive() and zip.Unarchive() failing with compressed tar archives
+func TestTarCompressorErrors(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "alpha"})
+
+	// Assert bzip2 Archives can't be Written
+	archive := filepath.Join(dir, "out.tar.bz2")
+	assert.True(t, errors.Is(Archive(source, archive), ErrCompressionNotSupported))
+	assert.NoFileExists(t, archive)
+
+	// Assert Corrupt Streams are Rejected
+	for _, ext := range []string{".tar.gz", ".tar.zst", ".tar.xz", ".tar.bz2"} {
+		archive := filepath.Join(dir, "corrupt"+ext)
+		assert.NoError(t, os.WriteFile(archive, []byte("this is not a compressed stream"), 0644))
+		assert.Error(t, Unarchive(archive, filepath.Join(dir, "corrupt")), ext)
+	}
+}
+
+// TestRegisterCompressor is a unit test for zip.RegisterCompressor()
+func TestRegisterCompressor(t *testing.T) {
+	RegisterCompressor(testCompressor{})
+	defer func() {
+		compressorsMutex.Lock()
+		compressors = compressors[:len(compressors)-1]
+		compressorsMutex.Unlock()
+	}()
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "alpha"})
+
+	// Assert Unit Test
+	archive := filepath.Join(dir, "out.tar.test")
+	assert.NoError(t, Archive(source, archive))
+	data, err := os.ReadFile(archive)
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST", string(data[:4]))
+	assert.NoError(t, Unarchive(archive, filepath.Join(dir, "out")))
+	assert.FileExists(t, filepath.Join(dir, "out", "a.txt"))
+
+	// Assert a Stream of Another Format is Rejected
+	assert.NoError(t, os.WriteFile(archive, []byte("gzip"), 0644))
+	assert.ErrorContains(t, Unarchive(archive, filepath.Join(dir, "bad")), "not a test stream")
+}
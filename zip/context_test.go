@@ -0,0 +1,123 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// cancelReader is an io.Reader cancelling a context after the first read
+type cancelReader struct {
+	reader io.Reader
+	cancel context.CancelFunc
+}
+
+// Read reads from the underlying reader and cancels the context
+func (r cancelReader) Read(p []byte) (int, error) {
+	defer r.cancel()
+	return r.reader.Read(p)
+}
+
+// TestContextReader is a unit test for zip.contextReader
+func TestContextReader(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	reader := contextReader{ctx, bytes.NewReader(make([]byte, 10))}
+
+	// Assert Unit Test
+	n, err := reader.Read(make([]byte, 4))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	cancel()
+	n, err = reader.Read(make([]byte, 4))
+	assert.Equal(t, 0, n)
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	// Assert a Copy in Progress Stops
+	ctx, cancel = context.WithCancel(context.Background())
+	reader = contextReader{ctx, cancelReader{bytes.NewReader(make([]byte, 1<<20)), cancel}}
+	copied, err := io.Copy(io.Discard, reader)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Less(t, copied, int64(1<<20))
+}
+
+// TestDownloadContext is a unit test for zip.DownloadContext()
+func TestDownloadContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(make([]byte, 1<<16))
+		if r.URL.Path == "/complete.zip" {
+			return
+		}
+
+		// Stall after the First Chunk until the Client goes Away
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+	dir := t.TempDir()
+
+	// Assert Unit Test
+	target := filepath.Join(dir, "complete.zip")
+	assert.NoError(t, DownloadContext(context.Background(), server.URL+"/complete.zip", target))
+	info, err := os.Stat(target)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1<<16), info.Size())
+
+	// Assert a Cancelled Download Stops Promptly and Removes the Partial Target
+	target = filepath.Join(dir, "stalled.zip")
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	assert.Error(t, DownloadContext(ctx, server.URL+"/stalled.zip", target))
+	assert.Less(t, time.Since(start), 2*time.Second)
+	assert.NoFileExists(t, target)
+}
+
+// TestArchiveContext is a unit test for zip.ArchiveContext() and zip.UnarchiveContext()
+func TestArchiveContext(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "alpha", "sub/b.txt": "bravo"})
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, ext := range []string{".zip", ".tar.gz"} {
+		archive := filepath.Join(dir, "out"+ext)
+
+		// Assert a Cancelled Archive leaves no Output
+		assert.True(t, errors.Is(ArchiveContext(cancelled, source, archive), context.Canceled), ext)
+		assert.NoFileExists(t, archive)
+
+		// Assert Unit Test
+		assert.NoError(t, ArchiveContext(context.Background(), source, archive), ext)
+		target := filepath.Join(dir, "out-"+ext)
+		assert.NoError(t, UnarchiveContext(context.Background(), archive, target), ext)
+		data, err := os.ReadFile(filepath.Join(target, "sub", "b.txt"))
+		assert.NoError(t, err)
+		assert.Equal(t, "bravo", string(data))
+
+		// Assert a Cancelled Unarchive extracts Nothing
+		target = filepath.Join(dir, "cancelled-"+ext)
+		assert.True(t, errors.Is(UnarchiveContext(cancelled, archive, target), context.Canceled), ext)
+		assert.NoFileExists(t, filepath.Join(target, "a.txt"))
+	}
+}
@@ -0,0 +1,414 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"archive/zip"
+	"compress/flate"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// WinZip AES Encryption (https://www.winzip.com/en/support/aes-encryption/)
+const (
+	methodAES       = 99     // compression method of AES encrypted entries
+	aesExtraID      = 0x9901 // extra field with the AES strength and actual compression method
+	aesIterations   = 1000   // PBKDF2 iterations
+	aesVerifierSize = 2      // password verification value
+	aesMACSize      = 10     // truncated HMAC-SHA1 authentication code
+)
+
+// encryptHeader marks a file header for WinZip AES-256 encryption (AE-1, which keeps
+// the CRC32 of the contents) and registers the encrypting compressor on the archive
+func encryptHeader(archive *zip.Writer, header *zip.FileHeader, password string, level int) {
+	method := header.Method
+
+	extra := make([]byte, 11)
+	binary.LittleEndian.PutUint16(extra[0:], aesExtraID)
+	binary.LittleEndian.PutUint16(extra[2:], 7)
+	binary.LittleEndian.PutUint16(extra[4:], 1)
+	copy(extra[6:], "AE")
+	extra[8] = 3
+	binary.LittleEndian.PutUint16(extra[9:], method)
+	header.Extra = append(header.Extra, extra...)
+	header.Method = methodAES
+	header.Flags |= 0x1
+
+	archive.RegisterCompressor(methodAES, func(w io.Writer) (io.WriteCloser, error) {
+		return newAESWriter(w, password, method, level)
+	})
+}
+
+// aesWriter compresses, encrypts and authenticates the contents of a zip entry
+type aesWriter struct {
+	io.Writer
+	compressor io.WriteCloser
+	encrypter  *aesEncrypter
+}
+
+// newAESWriter returns the writer for the contents of an AES-256 encrypted entry,
+// the salt and password verifier are written ahead of the first encrypted data
+// (archive/zip creates the compressor before writing the local file header)
+func newAESWriter(w io.Writer, password string, method uint16, level int) (io.WriteCloser, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	keys := pbkdf2.Key([]byte(password), salt, aesIterations, 2*32+aesVerifierSize, sha1.New)
+	block, err := aes.NewCipher(keys[:32])
+	if err != nil {
+		return nil, err
+	}
+
+	encrypter := &aesEncrypter{
+		w:      w,
+		header: append(salt, keys[64:]...),
+		stream: newAESCounter(block),
+		mac:    hmac.New(sha1.New, keys[32:64]),
+	}
+	writer := &aesWriter{Writer: encrypter, encrypter: encrypter}
+	switch method {
+	case zip.Store:
+	case zip.Deflate:
+		writer.compressor, err = flate.NewWriter(encrypter, level)
+		if err != nil {
+			return nil, err
+		}
+		writer.Writer = writer.compressor
+	default:
+		return nil, zip.ErrAlgorithm
+	}
+	return writer, nil
+}
+
+// Close flushes the compressor and writes the authentication code
+func (w *aesWriter) Close() error {
+	if w.compressor != nil {
+		if err := w.compressor.Close(); err != nil {
+			return err
+		}
+	}
+	if err := w.encrypter.writeHeader(); err != nil {
+		return err
+	}
+	_, err := w.encrypter.w.Write(w.encrypter.mac.Sum(nil)[:aesMACSize])
+	return err
+}
+
+// aesEncrypter encrypts data and adds it to the authentication code
+type aesEncrypter struct {
+	w      io.Writer
+	header []byte
+	stream cipher.Stream
+	mac    hash.Hash
+}
+
+// writeHeader writes the salt and password verifier once
+func (e *aesEncrypter) writeHeader() error {
+	if e.header == nil {
+		return nil
+	}
+	_, err := e.w.Write(e.header)
+	e.header = nil
+	return err
+}
+
+func (e *aesEncrypter) Write(p []byte) (int, error) {
+	if err := e.writeHeader(); err != nil {
+		return 0, err
+	}
+	buf := make([]byte, len(p))
+	e.stream.XORKeyStream(buf, p)
+	e.mac.Write(buf)
+	return e.w.Write(buf)
+}
+
+// aesCounter is AES in counter mode as used by WinZip: a little-endian counter starting at 1
+type aesCounter struct {
+	block     cipher.Block
+	counter   [aes.BlockSize]byte
+	keystream [aes.BlockSize]byte
+	used      int
+}
+
+func newAESCounter(block cipher.Block) *aesCounter {
+	return &aesCounter{block: block, used: aes.BlockSize}
+}
+
+func (c *aesCounter) XORKeyStream(dst []byte, src []byte) {
+	for i := range src {
+		if c.used == aes.BlockSize {
+			for j := range c.counter {
+				c.counter[j]++
+				if c.counter[j] != 0 {
+					break
+				}
+			}
+			c.block.Encrypt(c.keystream[:], c.counter[:])
+			c.used = 0
+		}
+		dst[i] = src[i] ^ c.keystream[c.used]
+		c.used++
+	}
+}
+
+// openZipFile opens the contents of a zip entry, decrypting entries encrypted with
+// WinZip AES or the legacy ZipCrypto with the password
+func openZipFile(file *zip.File, password string) (io.ReadCloser, error) {
+	if file.Flags&0x1 == 0 {
+		return file.Open()
+	}
+	if password == "" {
+		return nil, fmt.Errorf("Archive entry '%v' is encrypted, a password is required", file.Name)
+	}
+
+	raw, err := file.OpenRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	// Decrypt Entry
+	var decrypted io.Reader
+	method := file.Method
+	if file.Method == methodAES {
+		var strength byte
+		strength, method, err = parseAESExtra(file)
+		if err != nil {
+			return nil, err
+		}
+		decrypted, err = newAESReader(raw, file, password, strength)
+	} else {
+		decrypted, err = newZipCryptoReader(raw, file, password)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Decompress Entry
+	var contents io.ReadCloser
+	switch method {
+	case zip.Store:
+		contents = io.NopCloser(decrypted)
+	case zip.Deflate:
+		contents = flate.NewReader(decrypted)
+	default:
+		return nil, zip.ErrAlgorithm
+	}
+
+	checked := &checksumReader{reader: contents, hash: crc32.NewIEEE(), crc: file.CRC32}
+	if file.Method == methodAES {
+		checked.source = decrypted
+	}
+	return checked, nil
+}
+
+// parseAESExtra returns the key strength and actual compression method of an AES encrypted entry
+func parseAESExtra(file *zip.File) (byte, uint16, error) {
+	extra := file.Extra
+	for len(extra) >= 4 {
+		id := binary.LittleEndian.Uint16(extra[0:])
+		size := int(binary.LittleEndian.Uint16(extra[2:]))
+		if len(extra) < 4+size {
+			break
+		}
+		if id == aesExtraID && size >= 7 {
+			return extra[8], binary.LittleEndian.Uint16(extra[9:]), nil
+		}
+		extra = extra[4+size:]
+	}
+	return 0, 0, fmt.Errorf("Archive entry '%v' has no AES extra field", file.Name)
+}
+
+// aesReader decrypts the contents of an AES encrypted entry, checking the
+// authentication code as soon as the last encrypted byte is read
+type aesReader struct {
+	name      string
+	raw       io.Reader
+	remaining int64
+	stream    cipher.Stream
+	mac       hash.Hash
+	err       error
+}
+
+// newAESReader reads the salt and password verifier of an AES encrypted entry
+func newAESReader(raw io.Reader, file *zip.File, password string, strength byte) (io.Reader, error) {
+	if strength < 1 || strength > 3 {
+		return nil, fmt.Errorf("Archive entry '%v' has an unknown AES strength %d", file.Name, strength)
+	}
+	keySize := 8 + 8*int(strength)
+	saltSize := keySize / 2
+
+	header := make([]byte, saltSize+aesVerifierSize)
+	if _, err := io.ReadFull(raw, header); err != nil {
+		return nil, err
+	}
+	dataSize := int64(file.CompressedSize64) - int64(len(header)) - aesMACSize
+	if dataSize < 0 {
+		return nil, zip.ErrFormat
+	}
+
+	// Verify Password
+	keys := pbkdf2.Key([]byte(password), header[:saltSize], aesIterations, 2*keySize+aesVerifierSize, sha1.New)
+	if !hmac.Equal(keys[2*keySize:], header[saltSize:]) {
+		return nil, fmt.Errorf("Password for archive entry '%v' is incorrect", file.Name)
+	}
+	block, err := aes.NewCipher(keys[:keySize])
+	if err != nil {
+		return nil, err
+	}
+
+	return &aesReader{
+		name:      file.Name,
+		raw:       raw,
+		remaining: dataSize,
+		stream:    newAESCounter(block),
+		mac:       hmac.New(sha1.New, keys[keySize:2*keySize]),
+	}, nil
+}
+
+func (r *aesReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	if r.remaining == 0 {
+		r.err = r.authenticate()
+		return 0, r.err
+	}
+
+	// Decrypt Contents
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n, err := r.raw.Read(p)
+	r.remaining -= int64(n)
+	r.mac.Write(p[:n])
+	r.stream.XORKeyStream(p[:n], p[:n])
+	if err == io.EOF && r.remaining > 0 {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil && err != io.EOF {
+		return n, err
+	}
+
+	// Check Authentication Code with the Last Encrypted Byte (a decompressor may
+	// not read past the end of its stream)
+	if r.remaining == 0 {
+		r.err = r.authenticate()
+		return n, r.err
+	}
+	return n, nil
+}
+
+// authenticate reads and checks the authentication code of the entry, returning
+// io.EOF when it matches
+func (r *aesReader) authenticate() error {
+	code := make([]byte, aesMACSize)
+	if _, err := io.ReadFull(r.raw, code); err != nil {
+		return err
+	}
+	if !hmac.Equal(code, r.mac.Sum(nil)[:aesMACSize]) {
+		return fmt.Errorf("Archive entry '%v' failed authentication", r.name)
+	}
+	return io.EOF
+}
+
+// zipCryptoReader decrypts the contents of an entry encrypted with the legacy ZipCrypto
+type zipCryptoReader struct {
+	data io.Reader
+	keys [3]uint32
+}
+
+// newZipCryptoReader reads the encryption header of a ZipCrypto encrypted entry
+func newZipCryptoReader(raw io.Reader, file *zip.File, password string) (io.Reader, error) {
+	r := &zipCryptoReader{keys: [3]uint32{0x12345678, 0x23456789, 0x34567890}}
+	for _, b := range []byte(password) {
+		r.update(b)
+	}
+
+	header := make([]byte, 12)
+	if _, err := io.ReadFull(raw, header); err != nil {
+		return nil, err
+	}
+	r.decrypt(header)
+
+	// Verify Password (against the CRC32 or, with a data descriptor, the modification time)
+	check := header[11]
+	if check != byte(file.CRC32>>24) && (file.Flags&0x8 == 0 || check != byte(file.ModifiedTime>>8)) {
+		return nil, fmt.Errorf("Password for archive entry '%v' is incorrect", file.Name)
+	}
+
+	r.data = io.LimitReader(raw, int64(file.CompressedSize64)-int64(len(header)))
+	return r, nil
+}
+
+func (r *zipCryptoReader) Read(p []byte) (int, error) {
+	n, err := r.data.Read(p)
+	r.decrypt(p[:n])
+	return n, err
+}
+
+// decrypt decrypts data in place
+func (r *zipCryptoReader) decrypt(data []byte) {
+	for i, c := range data {
+		t := r.keys[2] | 2
+		data[i] = c ^ byte((t*(t^1))>>8)
+		r.update(data[i])
+	}
+}
+
+// update updates the keys with a plaintext byte
+func (r *zipCryptoReader) update(b byte) {
+	r.keys[0] = crc32.IEEETable[byte(r.keys[0])^b] ^ (r.keys[0] >> 8)
+	r.keys[1] = (r.keys[1]+(r.keys[0]&0xff))*134775813 + 1
+	r.keys[2] = crc32.IEEETable[byte(r.keys[2])^byte(r.keys[1]>>24)] ^ (r.keys[2] >> 8)
+}
+
+// checksumReader checks the CRC32 of the contents of an entry once all contents are
+// read (AE-2 encrypted entries have no CRC32) and reads the decrypted source to its
+// end, so the authentication code of an AES entry is checked even when the
+// decompressor stops early or fails on tampered contents
+type checksumReader struct {
+	reader io.ReadCloser
+	source io.Reader
+	hash   hash.Hash32
+	crc    uint32
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.hash.Write(p[:n])
+	if err == nil {
+		return n, nil
+	}
+
+	// Check Authentication Code (tampered contents fail authentication first)
+	if r.source != nil {
+		if _, drainErr := io.Copy(io.Discard, r.source); drainErr != nil {
+			return n, drainErr
+		}
+	}
+	if err == io.EOF && r.crc != 0 && r.hash.Sum32() != r.crc {
+		return n, zip.ErrChecksum
+	}
+	return n, err
+}
+
+func (r *checksumReader) Close() error {
+	return r.reader.Close()
+}
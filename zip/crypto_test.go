@@ -0,0 +1,134 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// encryptedTree writes an AES encrypted archive of a small tree with a CompressionLevel
+func encryptedTree(t *testing.T, dir string, level CompressionLevel) string {
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"secret.txt": strings.Repeat("top secret ", 500), "sub/empty.txt": ""})
+	archive := filepath.Join(dir, "encrypted.zip")
+	assert.NoError(t, Archive(source, archive, ArchiveOptions{Password: "hunter2", Level: level}))
+	return archive
+}
+
+// TestAESRoundTrip is a unit test for zip.Archive() and zip.Unarchive() with AES encryption
+func TestAESRoundTrip(t *testing.T) {
+	for _, level := range []CompressionLevel{Store, DefaultCompression} {
+		dir := t.TempDir()
+		archive := encryptedTree(t, dir, level)
+
+		// Assert Entries are Encrypted with the Compression Method
+		reader, err := zip.OpenReader(archive)
+		assert.NoError(t, err)
+		for _, file := range reader.File {
+			if file.Name != "secret.txt" {
+				continue
+			}
+			assert.Equal(t, uint16(methodAES), file.Method)
+			_, method, err := parseAESExtra(file)
+			assert.NoError(t, err)
+			if level == Store {
+				assert.Equal(t, zip.Store, method)
+			} else {
+				assert.Equal(t, zip.Deflate, method)
+			}
+		}
+		reader.Close()
+
+		// Assert Unit Test
+		target := filepath.Join(dir, "out")
+		assert.NoError(t, Unarchive(archive, target, UnarchiveOptions{Password: "hunter2"}))
+		data, err := os.ReadFile(filepath.Join(target, "secret.txt"))
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Repeat("top secret ", 500), string(data))
+		assert.FileExists(t, filepath.Join(target, "sub", "empty.txt"))
+	}
+}
+
+// TestAESWrongPassword is a unit test for zip.Unarchive() rejecting a missing or incorrect password
+func TestAESWrongPassword(t *testing.T) {
+	dir := t.TempDir()
+	archive := encryptedTree(t, dir, DefaultCompression)
+
+	// Assert Unit Test
+	target := filepath.Join(dir, "missing")
+	assert.ErrorContains(t, Unarchive(archive, target), "a password is required")
+	assert.NoFileExists(t, filepath.Join(target, "secret.txt"))
+	target = filepath.Join(dir, "wrong")
+	assert.ErrorContains(t, Unarchive(archive, target, UnarchiveOptions{Password: "hunter3"}), "is incorrect")
+	assert.NoFileExists(t, filepath.Join(target, "secret.txt"))
+	_, err := ReadEntry(archive, "secret.txt", UnarchiveOptions{Password: "hunter3"})
+	assert.Error(t, err)
+}
+
+// TestAESTampered is a unit test for zip.Unarchive() rejecting entries whose contents or authentication code were changed
+func TestAESTampered(t *testing.T) {
+	for _, level := range []CompressionLevel{Store, DefaultCompression} {
+		dir := t.TempDir()
+		archive := encryptedTree(t, dir, level)
+
+		// Find Encrypted Contents (salt, verifier, data and authentication code)
+		reader, err := zip.OpenReader(archive)
+		assert.NoError(t, err)
+		var offset, size int64
+		for _, file := range reader.File {
+			if file.Name == "secret.txt" {
+				offset, err = file.DataOffset()
+				assert.NoError(t, err)
+				size = int64(file.CompressedSize64)
+			}
+		}
+		reader.Close()
+		data, err := os.ReadFile(archive)
+		assert.NoError(t, err)
+
+		for name, index := range map[string]int64{"mac": offset + size - 1, "data": offset + 16 + aesVerifierSize + 1} {
+			tampered := append([]byte{}, data...)
+			tampered[index] ^= 0xff
+			path := filepath.Join(dir, name+".zip")
+			assert.NoError(t, os.WriteFile(path, tampered, 0644))
+
+			// Assert Unit Test
+			target := filepath.Join(dir, name)
+			assert.ErrorContains(t, Unarchive(path, target, UnarchiveOptions{Password: "hunter2"}), "failed authentication", "%v %v", level, name)
+			assert.NoFileExists(t, filepath.Join(target, "secret.txt"))
+		}
+	}
+}
+
+// TestDecryptExternalArchives is a unit test for zip.Unarchive() with archives encrypted by bsdtar (AES) and Info-ZIP (ZipCrypto)
+func TestDecryptExternalArchives(t *testing.T) {
+	lorem := strings.Repeat("lorem ipsum dolor sit amet ", 200) + "\n"
+
+	for _, fixture := range []string{"bsdtar-aes.zip", "infozip-zipcrypto.zip"} {
+		archive := filepath.Join("testdata", fixture)
+		target := filepath.Join(t.TempDir(), "out")
+
+		// Assert Unit Test
+		assert.NoError(t, Unarchive(archive, target, UnarchiveOptions{Password: "gogo"}), fixture)
+		data, err := os.ReadFile(filepath.Join(target, "hello.txt"))
+		assert.NoError(t, err, fixture)
+		assert.Equal(t, "hello from bsdtar\n", string(data), fixture)
+		data, err = os.ReadFile(filepath.Join(target, "lorem.txt"))
+		assert.NoError(t, err, fixture)
+		assert.Equal(t, lorem, string(data), fixture)
+
+		assert.Error(t, Unarchive(archive, filepath.Join(t.TempDir(), "wrong"), UnarchiveOptions{Password: "wrong"}), fixture)
+	}
+}
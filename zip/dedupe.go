@@ -0,0 +1,108 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// findDuplicates returns the entry name of the first copy of each regular file below
+// sources that repeats an earlier file (a hard link to it or a file with the same SHA256
+// checksum), keyed by entry name. Files are grouped by size first so only files sharing
+// a size are hashed. Empty files are ignored. Returns nil unless ArchiveOptions.Deduplicate
+// is set.
+func findDuplicates(sources []Source, filter *archiveFilter, options ArchiveOptions) (map[string]string, error) {
+	if !options.Deduplicate {
+		return nil, nil
+	}
+
+	// Group Files by Size (in the order they are written)
+	type sourceFile struct {
+		source Source
+		name   string
+		path   string
+		info   os.FileInfo
+	}
+	sizes := map[int64][]sourceFile{}
+	err := walkSources(sources, filter, func(source Source, name string, path string, info os.FileInfo) error {
+		if info.Mode().IsRegular() && info.Size() > 0 {
+			sizes[info.Size()] = append(sizes[info.Size()], sourceFile{source, name, path, info})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Find Copies of Earlier Files sharing a Size
+	duplicates := map[string]string{}
+	for _, files := range sizes {
+		if len(files) < 2 {
+			continue
+		}
+		var originals []sourceFile
+		checksums := map[string]string{}
+		for _, file := range files {
+
+			// Check Hard Links to an Earlier File
+			linked := false
+			for _, original := range originals {
+				if os.SameFile(original.info, file.info) {
+					duplicates[file.name] = original.name
+					linked = true
+					break
+				}
+			}
+			if linked {
+				continue
+			}
+
+			// Check Contents of Earlier Files
+			sum, err := checksum(file.source, file.path)
+			if err != nil {
+				return nil, err
+			}
+			if name, ok := checksums[sum]; ok {
+				duplicates[file.name] = name
+				continue
+			}
+			checksums[sum] = file.name
+			originals = append(originals, file)
+		}
+	}
+
+	return duplicates, nil
+}
+
+// checksum returns the SHA256 checksum of a file below a Source
+func checksum(source Source, path string) (string, error) {
+	file, err := source.open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// duplicateLink returns the relative target of a symbolic link from the entry name of
+// a duplicate file to the entry name of its first copy
+func duplicateLink(name string, original string) (string, error) {
+	link, err := filepath.Rel(filepath.Dir(filepath.FromSlash(name)), filepath.FromSlash(original))
+	return filepath.ToSlash(link), err
+}
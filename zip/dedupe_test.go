@@ -0,0 +1,98 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bytes"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDuplicateLink is a unit test for zip.duplicateLink()
+func TestDuplicateLink(t *testing.T) {
+	for name, expected := range map[string]string{
+		"vendor/b/lib.bin": "../a/lib.bin",
+		"lib.bin":          "vendor/a/lib.bin",
+		"vendor/a/x.bin":   "lib.bin",
+	} {
+		link, err := duplicateLink(name, "vendor/a/lib.bin")
+
+		// Assert Unit Test
+		assert.NoError(t, err)
+		assert.Equal(t, expected, link, name)
+	}
+}
+
+// TestArchiveDeduplicate is a unit test for zip.Archive() with ArchiveOptions.Deduplicate
+func TestArchiveDeduplicate(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	data := make([]byte, 200000)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+	changed := append([]byte{data[0] + 1}, data[1:]...)
+	writeTestTree(t, source, map[string]string{
+		"vendor/a/lib.bin": string(data),
+		"vendor/b/lib.bin": string(data),
+		"other.bin":        string(changed),
+		"e1":               "",
+		"e2":               "",
+	})
+	files := []string{"vendor/a/lib.bin", "vendor/b/lib.bin", "other.bin", "e1", "e2"}
+	if os.Link(filepath.Join(source, "other.bin"), filepath.Join(source, "z-hard.bin")) == nil {
+		files = append(files, "z-hard.bin")
+	}
+
+	for _, ext := range []string{".zip", ".tar.gz", ".tar"} {
+		plain := filepath.Join(dir, "plain"+ext)
+		deduplicated := filepath.Join(dir, "deduplicated"+ext)
+		assert.NoError(t, Archive(source, plain))
+		assert.NoError(t, Archive(source, deduplicated, ArchiveOptions{Deduplicate: true}))
+
+		// Assert Duplicates are Stored Once
+		plainInfo, err := os.Stat(plain)
+		assert.NoError(t, err)
+		deduplicatedInfo, err := os.Stat(deduplicated)
+		assert.NoError(t, err)
+		assert.Less(t, deduplicatedInfo.Size(), plainInfo.Size()/2+10000, ext)
+		assert.NoError(t, Verify(deduplicated), ext)
+
+		// Assert Unit Test
+		target := filepath.Join(dir, "out"+ext)
+		assert.NoError(t, Unarchive(deduplicated, target))
+		for _, name := range files {
+			expected, err := os.ReadFile(filepath.Join(source, name))
+			assert.NoError(t, err)
+			extracted, err := os.ReadFile(filepath.Join(target, name))
+			assert.NoError(t, err, name)
+			assert.True(t, bytes.Equal(expected, extracted), "%v %v", ext, name)
+		}
+	}
+}
+
+// TestArchiveDeduplicateErrors is a unit test for zip.Archive() failing to checksum a possible duplicate
+func TestArchiveDeduplicateErrors(t *testing.T) {
+	dir := t.TempDir()
+	fsys := unreadableFS{fstest.MapFS{
+		"a.txt":              {Data: []byte("same size!")},
+		"bad/unreadable.txt": {Data: []byte("unreadable")},
+	}}
+
+	// Assert Unit Test
+	for _, name := range []string{"fs.zip", "fs.tar.gz"} {
+		target := filepath.Join(dir, name)
+		assert.ErrorContains(t, ArchiveFS(fsys, target, ArchiveOptions{Deduplicate: true}), "device not ready", name)
+		assert.NoFileExists(t, target)
+	}
+}
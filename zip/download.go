@@ -0,0 +1,226 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DownloadResumable Function for Downloading a large Archive File from a HTTP Source over
+// a flaky network. The download is written to target + ".part" and renamed to target once
+// its size has been verified. Interrupted transfers are resumed with HTTP Range requests
+// (also across calls, from an existing .part file) and failed requests are retried with
+// exponential backoff. The Client, Headers and Timeout from DownloadOptions apply to
+// each request.
+func DownloadResumable(source string, target string, options DownloadOptions) error {
+
+	// Parse source url and validate 'source' is a valid HTTP URL
+	_, err := url.ParseRequestURI(source)
+	if err != nil {
+		return err
+	}
+
+	// Apply Download Options
+	retries := options.Retries
+	if retries == 0 {
+		retries = 3
+	}
+	backoff := options.Backoff
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+
+	download := &resumableDownload{source: source, part: target + ".part", options: options, total: -1}
+	for attempt := 0; ; attempt++ {
+		retry, err := download.attempt()
+		if err == nil {
+			break
+		}
+		if !retry || attempt >= retries {
+			// Remove an Empty Partial Download (a non-empty one can be resumed)
+			if info, statErr := os.Stat(download.part); statErr == nil && info.Size() == 0 {
+				os.Remove(download.part)
+			}
+			return err
+		}
+
+		// Wait before Retrying
+		time.Sleep(backoff << attempt)
+	}
+
+	// Rename Verified Download into place
+	return os.Rename(download.part, target)
+}
+
+// resumableDownload holds the state of a DownloadResumable call between attempts
+type resumableDownload struct {
+	source  string
+	part    string
+	options DownloadOptions
+	total   int64
+	etag    string
+}
+
+// errRangeNotSatisfiable is returned by an attempt when the server rejects the requested range
+var errRangeNotSatisfiable = errors.New("range not satisfiable")
+
+// attempt downloads the remaining bytes of the source, reporting whether a failed
+// attempt should be retried
+func (d *resumableDownload) attempt() (bool, error) {
+
+	// Open Partial Download
+	out, err := os.OpenFile(d.part, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return false, err
+	}
+	defer out.Close()
+	offset, err := out.Seek(0, io.SeekEnd)
+	if err != nil {
+		return false, err
+	}
+
+	// Check IF Download is Complete
+	if d.total >= 0 && offset == d.total {
+		return false, nil
+	}
+
+	// Request Remaining Bytes
+	ctx := context.Background()
+	if d.options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.options.Timeout)
+		defer cancel()
+	}
+	request, err := newRequest(ctx, d.source, d.options)
+	if err != nil {
+		return false, err
+	}
+	if offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		if d.etag != "" {
+			request.Header.Set("If-Range", d.etag)
+		}
+	}
+	resp, err := d.options.client().Do(request)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusPartialContent:
+		// Resume Partial Download
+		start, total, ok := parseContentRange(resp.Header.Get("Content-Range"))
+		if !ok || start != offset {
+			return false, fmt.Errorf("Download '%v' returned an invalid Content-Range '%v'", d.source, resp.Header.Get("Content-Range"))
+		}
+		d.total = total
+	case resp.StatusCode == http.StatusOK:
+		// Restart Download (the server doesn't support ranges or the source changed)
+		if err := out.Truncate(0); err != nil {
+			return false, err
+		}
+		if _, err := out.Seek(0, io.SeekStart); err != nil {
+			return false, err
+		}
+		offset = 0
+		d.total = resp.ContentLength
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable:
+		// Check IF Partial Download is already Complete
+		_, total, ok := parseContentRange(resp.Header.Get("Content-Range"))
+		if ok && total == offset {
+			d.total = total
+			return false, nil
+		}
+		out.Truncate(0)
+		return true, errRangeNotSatisfiable
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("Download '%v' failed: %v", d.source, resp.Status)
+	default:
+		return false, fmt.Errorf("Download '%v' failed: %v", d.source, resp.Status)
+	}
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		d.etag = etag
+	}
+
+	// Write the body to the partial download
+	written, err := io.Copy(out, withProgress(resp.Body, offset, d.total, d.options.Progress))
+	if err != nil {
+		return true, err
+	}
+
+	// Verify Download Size
+	if d.total >= 0 && offset+written != d.total {
+		return true, fmt.Errorf("Download '%v' is incomplete: %d of %d bytes", d.source, offset+written, d.total)
+	}
+	d.total = offset + written
+
+	return false, out.Close()
+}
+
+// client returns the http.Client for a download
+func (options DownloadOptions) client() *http.Client {
+	if options.Client != nil {
+		return options.Client
+	}
+	return http.DefaultClient
+}
+
+// newRequest creates a GET request for a download with the headers from DownloadOptions
+func newRequest(ctx context.Context, source string, options DownloadOptions) (*http.Request, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range options.Headers {
+		request.Header.Set(name, value)
+	}
+	return request, nil
+}
+
+// parseContentRange parses a Content-Range header ("bytes 100-199/200" or "bytes */200"),
+// returning the first byte position and the total size (-1 when unknown)
+func parseContentRange(header string) (int64, int64, bool) {
+	header = strings.TrimPrefix(header, "bytes ")
+	rng, size, ok := strings.Cut(header, "/")
+	if !ok {
+		return 0, 0, false
+	}
+
+	total := int64(-1)
+	if size != "*" {
+		var err error
+		total, err = strconv.ParseInt(size, 10, 64)
+		if err != nil {
+			return 0, 0, false
+		}
+	}
+	if rng == "*" {
+		return 0, total, true
+	}
+
+	first, _, ok := strings.Cut(rng, "-")
+	if !ok {
+		return 0, 0, false
+	}
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return start, total, true
+}
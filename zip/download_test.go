@@ -0,0 +1,165 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rangeServer is a HTTP server supporting Range requests, failing requests as scripted
+type rangeServer struct {
+	*httptest.Server
+	data     []byte
+	mutex    sync.Mutex
+	requests []string // Range header of each request
+	failures []int    // status codes (or -1 to drop the connection halfway) of the first requests
+}
+
+// newRangeServer starts a rangeServer serving data
+func newRangeServer(t *testing.T, data []byte, failures ...int) *rangeServer {
+	server := &rangeServer{data: data, failures: failures}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serve))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// serve handles a request
+func (s *rangeServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	s.requests = append(s.requests, r.Header.Get("Range"))
+	failure := 0
+	if len(s.failures) > 0 {
+		failure, s.failures = s.failures[0], s.failures[1:]
+	}
+	s.mutex.Unlock()
+	if failure > 0 {
+		w.WriteHeader(failure)
+		return
+	}
+
+	// Serve Requested Range
+	start := 0
+	w.Header().Set("ETag", `"v1"`)
+	if header := r.Header.Get("Range"); header != "" {
+		start, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(header, "bytes="), "-"))
+		if start >= len(s.data) {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", len(s.data)))
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(s.data)-1, len(s.data)))
+		w.Header().Set("Content-Length", strconv.Itoa(len(s.data)-start))
+		w.WriteHeader(http.StatusPartialContent)
+	} else {
+		w.Header().Set("Content-Length", strconv.Itoa(len(s.data)))
+	}
+	body := s.data[start:]
+	if failure == -1 {
+		// Drop the Connection Halfway
+		w.Write(body[:len(body)/2])
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		conn.Close()
+		return
+	}
+	w.Write(body)
+}
+
+// TestParseContentRange is a unit test for zip.parseContentRange()
+func TestParseContentRange(t *testing.T) {
+	for header, expected := range map[string][3]int64{
+		"bytes 5-9/10":   {5, 10, 1},
+		"bytes 0-99/*":   {0, -1, 1},
+		"bytes */200":    {0, 200, 1},
+		"bytes 5-9":      {0, 0, 0},
+		"bytes x-9/10":   {0, 0, 0},
+		"bytes 5-9/many": {0, 0, 0},
+	} {
+		start, total, ok := parseContentRange(header)
+
+		// Assert Unit Test
+		assert.Equal(t, expected[2] == 1, ok, header)
+		assert.Equal(t, expected[0], start, header)
+		assert.Equal(t, expected[1], total, header)
+	}
+}
+
+// TestDownloadResumable is a unit test for zip.DownloadResumable() retrying and resuming an interrupted download
+func TestDownloadResumable(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), 10000)
+	server := newRangeServer(t, data, http.StatusServiceUnavailable, -1)
+	target := filepath.Join(t.TempDir(), "a.zip")
+
+	// Assert Unit Test
+	assert.NoError(t, DownloadResumable(server.URL, target, DownloadOptions{Backoff: time.Millisecond}))
+	downloaded, err := os.ReadFile(target)
+	assert.NoError(t, err)
+	assert.Equal(t, data, downloaded)
+	assert.NoFileExists(t, target+".part")
+	assert.Equal(t, []string{"", "", "bytes=50000-"}, server.requests)
+}
+
+// TestDownloadResumablePart is a unit test for zip.DownloadResumable() resuming from an existing .part file
+func TestDownloadResumablePart(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), 10000)
+	server := newRangeServer(t, data)
+	target := filepath.Join(t.TempDir(), "a.zip")
+
+	// Assert Unit Test
+	assert.NoError(t, os.WriteFile(target+".part", data[:30000], 0644))
+	assert.NoError(t, DownloadResumable(server.URL, target, DownloadOptions{Backoff: time.Millisecond}))
+	downloaded, err := os.ReadFile(target)
+	assert.NoError(t, err)
+	assert.Equal(t, data, downloaded)
+	assert.Equal(t, []string{"bytes=30000-"}, server.requests)
+
+	// Assert a Complete .part File is Renamed
+	server.requests = nil
+	assert.NoError(t, os.WriteFile(target+".part", data, 0644))
+	assert.NoError(t, DownloadResumable(server.URL, target, DownloadOptions{Backoff: time.Millisecond}))
+	assert.Equal(t, []string{"bytes=100000-"}, server.requests)
+	assert.NoFileExists(t, target+".part")
+}
+
+// TestDownloadResumableErrors is a unit test for zip.DownloadResumable() failing
+func TestDownloadResumableErrors(t *testing.T) {
+	data := []byte("archive")
+	dir := t.TempDir()
+	target := filepath.Join(dir, "a.zip")
+
+	// Assert a Client Error is not Retried
+	server := newRangeServer(t, data, http.StatusNotFound)
+	assert.ErrorContains(t, DownloadResumable(server.URL, target, DownloadOptions{Backoff: time.Millisecond}), "404 Not Found")
+	assert.Len(t, server.requests, 1)
+	assert.NoFileExists(t, target)
+	assert.NoFileExists(t, target+".part")
+
+	// Assert Retries are Limited
+	server = newRangeServer(t, data, 500, 500, 500)
+	assert.ErrorContains(t, DownloadResumable(server.URL, target, DownloadOptions{Retries: 2, Backoff: time.Millisecond}), "500 Internal Server Error")
+	assert.Len(t, server.requests, 3)
+	server = newRangeServer(t, data, 503)
+	assert.Error(t, DownloadResumable(server.URL, target, DownloadOptions{Retries: -1}))
+	assert.Len(t, server.requests, 1)
+	assert.NoFileExists(t, target)
+
+	// Assert an Invalid URL is Rejected
+	assert.Error(t, DownloadResumable("not a url", target, DownloadOptions{}))
+}
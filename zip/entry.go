@@ -0,0 +1,161 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// ErrEntryNotFound is returned by ExtractFile and ReadEntry for entry names not in the archive
+var ErrEntryNotFound = errors.New("archive entry not found")
+
+// ExtractFile Function for Writing the contents of a single entry of an Archive File (.zip
+// or a tar archive) to a writer without extracting the rest of the archive. Entry names
+// are matched after cleaning (e.g. "./docs/README.md" matches "docs/README.md").
+func ExtractFile(source string, entryName string, w io.Writer, options ...UnarchiveOptions) error {
+
+	// Validate Parameters
+	if source == "" {
+		return fmt.Errorf("The 'source' parameter was empty. A source is required to extract a File")
+	}
+	if entryName == "" {
+		return fmt.Errorf("The 'entryName' parameter was empty. An entry name is required to extract a File")
+	}
+
+	// Apply Unarchive Options
+	unarchiveOptions := UnarchiveOptions{}
+	if len(options) > 0 {
+		unarchiveOptions = options[0]
+	}
+
+	// Apply Extraction Limits (MaxEntrySize and MaxSize)
+	limits := newExtractLimits(unarchiveOptions)
+
+	// Extract from Tar Archives
+	if compressor, ok := tarFormat(source); ok {
+		return extractTarEntry(source, entryName, w, compressor, limits, true)
+	}
+
+	// Create a zipReader out of the Source (.zip, or the parts of a split or spanned archive)
+	zipReader, closer, err := openZipArchive(source)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	// Find Entry
+	for _, file := range zipReader.File {
+		if !sameEntry(file.Name, entryName) {
+			continue
+		}
+		if file.Mode().IsDir() {
+			return fmt.Errorf("Archive entry '%v' is a directory", file.Name)
+		}
+		if !file.Mode().IsRegular() {
+			return fmt.Errorf("Archive entry '%v' is not a regular file", file.Name)
+		}
+
+		// Copy Entry Contents (decrypting it with the password)
+		err = limits.entry(file.Name, int64(file.UncompressedSize64))
+		if err != nil {
+			return err
+		}
+		contents, err := openZipFile(file, unarchiveOptions.Password)
+		if err != nil {
+			return err
+		}
+		defer contents.Close()
+
+		_, err = io.Copy(w, limits.reader(contents))
+		return err
+	}
+
+	return fmt.Errorf("Archive entry '%v' in '%v': %w", entryName, source, ErrEntryNotFound)
+}
+
+// ReadEntry Function for Reading the contents of a single entry of an Archive File
+// (.zip or a tar archive), e.g. a manifest or metadata file
+func ReadEntry(source string, entryName string, options ...UnarchiveOptions) ([]byte, error) {
+	var buffer bytes.Buffer
+	err := ExtractFile(source, entryName, &buffer, options...)
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// extractTarEntry writes the contents of a single tar entry to a writer, decompressed
+// with the Compressor unless it is nil. A hard link entry is followed to the file it
+// links to when follow is set.
+func extractTarEntry(source string, entryName string, w io.Writer, compressor Compressor, limits *extractLimits, follow bool) error {
+
+	// Open Source Archive (joining the parts of a split archive)
+	file, _, err := openArchiveFile(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Create Decompressed Reader
+	var reader io.Reader = file
+	if compressor != nil {
+		decompressed, err := compressor.Reader(file)
+		if err != nil {
+			return fmt.Errorf("Unable to read %v archive '%v': %v", compressor.Name(), source, err)
+		}
+		defer decompressed.Close()
+		reader = decompressed
+	}
+
+	// Find Entry
+	archive := tar.NewReader(reader)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return fmt.Errorf("Archive entry '%v' in '%v': %w", entryName, source, ErrEntryNotFound)
+		}
+		if err != nil {
+			return err
+		}
+		if !sameEntry(header.Name, entryName) {
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeReg:
+			err = limits.entry(header.Name, header.Size)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(w, limits.reader(archive))
+			return err
+		case tar.TypeDir:
+			return fmt.Errorf("Archive entry '%v' is a directory", header.Name)
+		case tar.TypeLink:
+			if follow {
+				return extractTarEntry(source, header.Linkname, w, compressor, limits, false)
+			}
+		}
+		return fmt.Errorf("Archive entry '%v' is not a regular file", header.Name)
+	}
+}
+
+// sameEntry compares archive entry names after cleaning them
+func sameEntry(a string, b string) bool {
+	clean := func(name string) string {
+		return strings.TrimPrefix(path.Clean("/"+strings.ReplaceAll(name, `\`, "/")), "/")
+	}
+	return clean(a) == clean(b)
+}
@@ -0,0 +1,101 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSameEntry is a unit test for zip.sameEntry()
+func TestSameEntry(t *testing.T) {
+	assert.True(t, sameEntry("meta/manifest.json", "meta/manifest.json"))
+	assert.True(t, sameEntry("./meta/manifest.json", "meta//manifest.json"))
+	assert.True(t, sameEntry(`meta\manifest.json`, "/meta/manifest.json"))
+	assert.False(t, sameEntry("meta/manifest.json", "manifest.json"))
+}
+
+// TestReadEntry is a unit test for zip.ReadEntry() and zip.ExtractFile()
+func TestReadEntry(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"meta/manifest.json": `{"v":1}`, "big": string(make([]byte, 100000))})
+
+	for _, name := range []string{"entry.zip", "entry.tar.gz"} {
+		archive := filepath.Join(dir, name)
+		assert.NoError(t, Archive(source, archive))
+
+		// Assert Unit Test
+		for _, entry := range []string{"meta/manifest.json", "./meta//manifest.json"} {
+			data, err := ReadEntry(archive, entry)
+			assert.NoError(t, err, name)
+			assert.Equal(t, `{"v":1}`, string(data), name)
+		}
+		var buffer bytes.Buffer
+		assert.NoError(t, ExtractFile(archive, "big", &buffer))
+		assert.Equal(t, 100000, buffer.Len())
+	}
+
+	// Assert Entries of Encrypted Archives
+	archive := filepath.Join(dir, "encrypted.zip")
+	assert.NoError(t, Archive(source, archive, ArchiveOptions{Password: "pw"}))
+	data, err := ReadEntry(archive, "meta/manifest.json", UnarchiveOptions{Password: "pw"})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"v":1}`, string(data))
+
+	// Assert Hard Links are Followed
+	archive = filepath.Join(dir, "links.tar")
+	file, err := os.Create(archive)
+	assert.NoError(t, err)
+	w := tar.NewWriter(file)
+	assert.NoError(t, w.WriteHeader(&tar.Header{Name: "./a.txt", Mode: 0644, Size: 5, Typeflag: tar.TypeReg}))
+	_, err = w.Write([]byte("alpha"))
+	assert.NoError(t, err)
+	assert.NoError(t, w.WriteHeader(&tar.Header{Name: "./b.txt", Linkname: "./a.txt", Typeflag: tar.TypeLink}))
+	assert.NoError(t, w.Close())
+	assert.NoError(t, file.Close())
+	data, err = ReadEntry(archive, "b.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "alpha", string(data))
+}
+
+// TestReadEntryErrors is a unit test for zip.ReadEntry() failing on missing, directory and link entries
+func TestReadEntryErrors(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"meta/manifest.json": `{"v":1}`})
+	links := filepath.Join(dir, "links")
+	writeTestTar(t, links+".tar", testEntry{name: "a.txt", body: "alpha"}, testEntry{name: "link", link: "a.txt"})
+	writeTestZip(t, links+".zip", testEntry{name: "a.txt", body: "alpha"}, testEntry{name: "link", link: "a.txt"})
+
+	for _, name := range []string{"entry.zip", "entry.tar.gz"} {
+		archive := filepath.Join(dir, name)
+		assert.NoError(t, Archive(source, archive))
+
+		// Assert Unit Test
+		_, err := ReadEntry(archive, "missing")
+		assert.True(t, errors.Is(err, ErrEntryNotFound), "%v: %v", name, err)
+		_, err = ReadEntry(archive, "meta")
+		assert.ErrorContains(t, err, "is a directory", name)
+		_, err = ReadEntry(archive, "")
+		assert.ErrorContains(t, err, "The 'entryName' parameter was empty", name)
+	}
+	for _, archive := range []string{links + ".tar", links + ".zip"} {
+		_, err := ReadEntry(archive, "link")
+		assert.ErrorContains(t, err, "is not a regular file", archive)
+	}
+	_, err := ReadEntry(filepath.Join(dir, "missing.zip"), "a.txt")
+	assert.Error(t, err)
+}
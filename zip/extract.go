@@ -0,0 +1,230 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/knowntraveler/gogo/fs"
+)
+
+// maxLinkSize limits the length of a symbolic link target read from an archive entry
+const maxLinkSize = 4096
+
+// extractFile writes the contents of an archive entry to a file, removing the partially
+// written file on failure (or when the extractLimits are exceeded), and restores its
+// permissions and modification time
+func extractFile(ctx context.Context, r io.Reader, path string, mode os.FileMode, modified time.Time, progress *archiveProgress, limits *extractLimits) error {
+
+	// Create Directory Path
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	// Create an output file for writing
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	// Copy Entry Contents (blocks of zeros are left as holes to preserve sparse files)
+	_, err = fs.CopySparse(f, progress.reader(limits.reader(contextReader{ctx, r})))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+
+	// Restore Permissions and Modification Time
+	return setMetadata(path, mode, modified)
+}
+
+// readLink reads the target of a symbolic link stored as the contents of an archive entry
+func readLink(r io.Reader) (string, error) {
+	link, err := io.ReadAll(io.LimitReader(r, maxLinkSize))
+	return string(link), err
+}
+
+// setMetadata restores the permissions and modification time of an extracted file
+// (permissions are set with Chmod so they are not subject to umask)
+func setMetadata(path string, mode os.FileMode, modified time.Time) error {
+	err := os.Chmod(path, mode)
+	if err != nil {
+		return err
+	}
+	if modified.IsZero() {
+		return nil
+	}
+	return os.Chtimes(path, modified, modified)
+}
+
+// fileMode returns the permissions of an archive entry, falling back to a default
+// for archives that don't record permissions
+func fileMode(mode os.FileMode, isDir bool) os.FileMode {
+	switch {
+	case mode.Perm() != 0:
+		return mode.Perm()
+	case isDir:
+		return 0755
+	}
+	return 0644
+}
+
+// checkConflict applies the OverwritePolicy of UnarchiveOptions to an entry whose
+// target path already exists, reporting whether the entry is extracted
+func checkConflict(name string, path string, modified time.Time, options UnarchiveOptions) (bool, error) {
+	existing, err := os.Lstat(path)
+	if err != nil {
+		return true, nil
+	}
+	return resolveConflict(name, path, modified, existing, options)
+}
+
+// resolveConflict applies the OverwritePolicy of UnarchiveOptions to an entry whose
+// target path exists as the existing file, reporting whether the entry is extracted
+func resolveConflict(name string, path string, modified time.Time, existing os.FileInfo, options UnarchiveOptions) (bool, error) {
+
+	// Choose Overwrite Policy
+	policy := options.Overwrite
+	if options.OnConflict != nil {
+		policy = options.OnConflict(Conflict{Name: name, Path: path, Modified: modified, Existing: existing})
+	}
+
+	switch policy {
+	case OverwriteFail:
+		return false, fmt.Errorf("File '%v' already exists", path)
+	case OverwriteSkip:
+		return false, nil
+	case OverwriteIfNewer:
+		return modified.After(existing.ModTime()), nil
+	}
+	return true, nil
+}
+
+// extractedDir is an extracted directory whose metadata is restored once all entries
+// are extracted (a read-only directory would prevent extracting the entries below it
+// and each extracted entry updates the modification time of its directory)
+type extractedDir struct {
+	path     string
+	mode     os.FileMode
+	modified time.Time
+}
+
+// restoreDirs restores the metadata of extracted directories, deepest first
+func restoreDirs(dirs []extractedDir) error {
+	for i := len(dirs) - 1; i >= 0; i-- {
+		err := setMetadata(dirs[i].path, dirs[i].mode, dirs[i].modified)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractSymlink creates a symbolic link entry, links pointing outside the target
+// directory are handled by UnarchiveOptions.Links unless UnarchiveOptions.Unsafe is set
+func extractSymlink(target string, path string, link string, options UnarchiveOptions) error {
+
+	// Check Link Target
+	if !options.Unsafe {
+		var err error
+		link, err = safeLink(target, path, link, options.Links)
+		if err != nil || link == "" {
+			return err
+		}
+	}
+
+	// Create Directory Path
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	// Replace Existing File or Link
+	if info, err := os.Lstat(path); err == nil && !info.IsDir() {
+		err = os.Remove(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.Symlink(link, path)
+}
+
+// extractHardLink creates a hard link entry to a file extracted earlier (named by its
+// archive entry name), copying the file where hard links are not supported
+func extractHardLink(target string, path string, name string, options UnarchiveOptions) error {
+
+	// Set Linked Filepath (rejecting names that escape the target directory)
+	linked, err := extractPath(target, name, options)
+	if err != nil {
+		return fmt.Errorf("Hard link '%v' is unsafe: %v", name, err)
+	}
+
+	// Create Directory Path
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	// Replace Existing File or Link
+	if info, err := os.Lstat(path); err == nil && !info.IsDir() {
+		err = os.Remove(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = os.Link(linked, path)
+	if err != nil {
+		return fs.CopyFile(linked, path)
+	}
+	return nil
+}
+
+// safeLink returns the target of a symbolic link at file, applying the LinkPolicy
+// to links pointing outside the target directory ("" skips the link)
+func safeLink(target string, file string, link string, policy LinkPolicy) (string, error) {
+	link = filepath.FromSlash(link)
+	absolute := filepath.IsAbs(link) || filepath.VolumeName(link) != "" || strings.HasPrefix(link, string(filepath.Separator))
+
+	// Check Relative Links resolve inside the Target Directory
+	if !absolute && fs.IsSubPath(resolvePath(target), resolvePath(filepath.Join(filepath.Dir(file), link))) {
+		return link, nil
+	}
+
+	switch {
+	case policy == LinkSkip:
+		return "", nil
+	case policy == LinkRewrite && absolute:
+		return rootLink(target, file, link)
+	}
+	return "", fmt.Errorf("Symbolic link '%v' points outside '%v'", link, target)
+}
+
+// rootLink rewrites an absolute link target to the same path below the target
+// directory, relative to the directory of the link at file
+func rootLink(target string, file string, link string) (string, error) {
+	name := filepath.ToSlash(strings.TrimPrefix(link, filepath.VolumeName(link)))
+	rooted, err := fs.SanitizePath(target, strings.TrimPrefix(path.Clean("/"+name), "/"))
+	if err != nil {
+		return "", err
+	}
+	return filepath.Rel(filepath.Dir(file), rooted)
+}
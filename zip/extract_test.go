@@ -0,0 +1,356 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/knowntraveler/gogo/fs"
+	"github.com/stretchr/testify/assert"
+)
+
+// testEntry is an entry of an archive written by the unit tests, a symbolic link
+// when link is set
+type testEntry struct {
+	name string
+	body string
+	link string
+}
+
+// writeTestZip writes a zip archive of entries for the unit tests
+func writeTestZip(t *testing.T, path string, entries ...testEntry) {
+	file, err := os.Create(path)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	w := zip.NewWriter(file)
+	for _, entry := range entries {
+		header := &zip.FileHeader{Name: entry.name, Method: zip.Deflate}
+		body := entry.body
+		switch {
+		case entry.link != "":
+			header.SetMode(os.ModeSymlink | 0777)
+			body = entry.link
+		case entry.name[len(entry.name)-1] == '/':
+			header.SetMode(os.ModeDir | 0755)
+		default:
+			header.SetMode(0644)
+		}
+		f, err := w.CreateHeader(header)
+		assert.NoError(t, err)
+		_, err = f.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+}
+
+// writeTestTar writes an uncompressed tar archive of entries for the unit tests
+func writeTestTar(t *testing.T, path string, entries ...testEntry) {
+	file, err := os.Create(path)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	w := tar.NewWriter(file)
+	for _, entry := range entries {
+		header := &tar.Header{Name: entry.name, Mode: 0644, Size: int64(len(entry.body)), Typeflag: tar.TypeReg}
+		if entry.link != "" {
+			header = &tar.Header{Name: entry.name, Mode: 0777, Linkname: entry.link, Typeflag: tar.TypeSymlink}
+		}
+		assert.NoError(t, w.WriteHeader(header))
+		_, err = w.Write([]byte(entry.body))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+}
+
+// writeTestTree writes the files of a source tree for the unit tests
+func writeTestTree(t *testing.T, dir string, files map[string]string) {
+	for name, body := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte(body), 0644))
+	}
+}
+
+// supportsSymlinks skips a unit test where symbolic links can't be created
+func supportsSymlinks(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Symlink("target", filepath.Join(dir, "link")); err != nil {
+		t.Skip("symlinks are not supported")
+	}
+}
+
+// TestUnarchive is a unit test for zip.Archive() and zip.Unarchive() extracting a tree unchanged
+func TestUnarchive(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "alpha", "sub/b.txt": "bravo", "sub/deep/c.txt": "charlie"})
+	assert.NoError(t, os.Chmod(filepath.Join(source, "sub", "b.txt"), 0600))
+
+	for _, name := range []string{"tree.zip", "tree.tar", "tree.tar.gz"} {
+		archive := filepath.Join(dir, name)
+		target := filepath.Join(dir, "out-"+name)
+		assert.NoError(t, Archive(source, archive))
+		assert.NoError(t, Unarchive(archive, target))
+
+		// Assert Unit Test
+		for file, body := range map[string]string{"a.txt": "alpha", "sub/b.txt": "bravo", "sub/deep/c.txt": "charlie"} {
+			data, err := os.ReadFile(filepath.Join(target, filepath.FromSlash(file)))
+			assert.NoError(t, err, name)
+			assert.Equal(t, body, string(data), name)
+		}
+		if info, err := os.Stat(filepath.Join(target, "sub", "b.txt")); assert.NoError(t, err) && os.PathSeparator == '/' {
+			assert.Equal(t, os.FileMode(0600), info.Mode().Perm(), name)
+		}
+	}
+}
+
+// TestUnarchiveUnsafeNames is a unit test for zip.Unarchive() rejecting entry names that escape the target
+func TestUnarchiveUnsafeNames(t *testing.T) {
+	dir := t.TempDir()
+	names := []string{"../evil.txt", "sub/../../evil.txt", "/evil.txt", `..\evil.txt`, "C:/evil.txt"}
+
+	for _, name := range names {
+		for _, format := range []string{".zip", ".tar"} {
+			archive := filepath.Join(dir, "evil"+format)
+			target := filepath.Join(dir, "out", "target")
+			entries := []testEntry{{name: "good.txt", body: "good"}, {name: name, body: "evil"}}
+			if format == ".zip" {
+				writeTestZip(t, archive, entries...)
+			} else {
+				writeTestTar(t, archive, entries...)
+			}
+
+			// Assert Unit Test
+			err := Unarchive(archive, target)
+			assert.Error(t, err, "%v %v", format, name)
+			assert.NoFileExists(t, filepath.Join(dir, "out", "evil.txt"), "%v %v", format, name)
+			assert.NoFileExists(t, filepath.Join(dir, "evil.txt"), "%v %v", format, name)
+			assert.NoError(t, os.RemoveAll(filepath.Join(dir, "out")))
+		}
+	}
+
+	// Assert Unsafe extracts Traversal Names
+	archive := filepath.Join(dir, "evil.zip")
+	writeTestZip(t, archive, testEntry{name: "../evil.txt", body: "evil"})
+	target := filepath.Join(dir, "out", "target")
+	assert.NoError(t, os.MkdirAll(target, 0755))
+	assert.NoError(t, Unarchive(archive, target, UnarchiveOptions{Unsafe: true}))
+	assert.FileExists(t, filepath.Join(dir, "out", "evil.txt"))
+}
+
+// TestUnarchiveSymlinkEscape is a unit test for zip.Unarchive() rejecting entries written through symbolic links
+func TestUnarchiveSymlinkEscape(t *testing.T) {
+	supportsSymlinks(t)
+	dir := t.TempDir()
+	outside := filepath.Join(dir, "outside")
+	target := filepath.Join(dir, "target")
+	assert.NoError(t, os.MkdirAll(outside, 0755))
+	assert.NoError(t, os.MkdirAll(target, 0755))
+
+	// Assert a Symbolic Link already in the Target is not followed
+	assert.NoError(t, os.Symlink(outside, filepath.Join(target, "link")))
+	archive := filepath.Join(dir, "evil.zip")
+	writeTestZip(t, archive, testEntry{name: "link/evil.txt", body: "evil"})
+	assert.Error(t, Unarchive(archive, target))
+	assert.NoFileExists(t, filepath.Join(outside, "evil.txt"))
+
+	// Assert a Symbolic Link Entry followed by a File through it is rejected
+	for _, format := range []string{".zip", ".tar"} {
+		archive = filepath.Join(dir, "evil"+format)
+		fresh := filepath.Join(dir, "fresh"+format)
+		entries := []testEntry{{name: "escape", link: outside}, {name: "escape/evil.txt", body: "evil"}}
+		if format == ".zip" {
+			writeTestZip(t, archive, entries...)
+		} else {
+			writeTestTar(t, archive, entries...)
+		}
+		for _, policy := range []LinkPolicy{LinkReject, LinkSkip, LinkRewrite} {
+			Unarchive(archive, fresh, UnarchiveOptions{Links: policy})
+			assert.NoFileExists(t, filepath.Join(outside, "evil.txt"), "%v %v", format, policy)
+			assert.NoError(t, os.RemoveAll(fresh))
+		}
+	}
+}
+
+// TestUnarchiveLinkPolicy is a unit test for zip.Unarchive() with each zip.LinkPolicy
+func TestUnarchiveLinkPolicy(t *testing.T) {
+	supportsSymlinks(t)
+	dir := t.TempDir()
+	absolute := filepath.Join(dir, "outside", "file.txt")
+	inside := testEntry{name: "a/in", link: "../b.txt"}
+	rooted := testEntry{name: "a/abs", link: absolute}
+	relative := testEntry{name: "a/rel", link: "../../outside/file.txt"}
+	archive := filepath.Join(dir, "links.zip")
+
+	// Assert LinkReject
+	writeTestZip(t, archive, testEntry{name: "b.txt", body: "b"}, inside, rooted)
+	target := filepath.Join(dir, "reject")
+	assert.ErrorContains(t, Unarchive(archive, target), "points outside")
+	writeTestZip(t, archive, testEntry{name: "b.txt", body: "b"}, inside, relative)
+	assert.ErrorContains(t, Unarchive(archive, filepath.Join(dir, "reject-rel")), "points outside")
+
+	// Assert LinkSkip
+	writeTestZip(t, archive, testEntry{name: "b.txt", body: "b"}, inside, rooted, relative)
+	target = filepath.Join(dir, "skip")
+	assert.NoError(t, Unarchive(archive, target, UnarchiveOptions{Links: LinkSkip}))
+	link, err := os.Readlink(filepath.Join(target, "a", "in"))
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.FromSlash("../b.txt"), link)
+	_, err = os.Lstat(filepath.Join(target, "a", "abs"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Lstat(filepath.Join(target, "a", "rel"))
+	assert.True(t, os.IsNotExist(err))
+
+	// Assert LinkRewrite roots Absolute Links and rejects Relative Links
+	writeTestZip(t, archive, testEntry{name: "b.txt", body: "b"}, inside, rooted)
+	target = filepath.Join(dir, "rewrite")
+	assert.NoError(t, Unarchive(archive, target, UnarchiveOptions{Links: LinkRewrite}))
+	link, err = os.Readlink(filepath.Join(target, "a", "abs"))
+	assert.NoError(t, err)
+	assert.False(t, filepath.IsAbs(link))
+	assert.True(t, fs.IsSubPath(target, filepath.Join(target, "a", link)), link)
+	writeTestZip(t, archive, testEntry{name: "b.txt", body: "b"}, relative)
+	assert.Error(t, Unarchive(archive, filepath.Join(dir, "rewrite-rel"), UnarchiveOptions{Links: LinkRewrite}))
+}
+
+// TestFileMode is a unit test for zip.fileMode()
+func TestFileMode(t *testing.T) {
+	assert.Equal(t, os.FileMode(0750), fileMode(os.ModeDir|0750, true))
+	assert.Equal(t, os.FileMode(0600), fileMode(0600, false))
+	assert.Equal(t, os.FileMode(0755), fileMode(os.ModeDir, true))
+	assert.Equal(t, os.FileMode(0644), fileMode(0, false))
+}
+
+// TestUnarchiveMetadata is a unit test for zip.Unarchive() restoring permissions, modification times and symbolic links
+func TestUnarchiveMetadata(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permissions are not supported")
+	}
+	supportsSymlinks(t)
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"bin/tool": "#!/bin/sh\n", "ro/f": "x"})
+	assert.NoError(t, os.Chmod(filepath.Join(source, "bin", "tool"), 0755))
+	assert.NoError(t, os.Chmod(filepath.Join(source, "ro", "f"), 0600))
+	assert.NoError(t, os.Chmod(filepath.Join(source, "ro"), 0555))
+	defer os.Chmod(filepath.Join(source, "ro"), 0755)
+	modified := time.Date(2020, 1, 2, 3, 4, 6, 0, time.UTC)
+	assert.NoError(t, os.Chtimes(filepath.Join(source, "bin", "tool"), modified, modified))
+	assert.NoError(t, os.Chtimes(filepath.Join(source, "bin"), modified, modified))
+	assert.NoError(t, os.Symlink("bin/tool", filepath.Join(source, "link")))
+	assert.NoError(t, os.Symlink("bin", filepath.Join(source, "dirlink")))
+
+	for _, name := range []string{"meta.zip", "meta.tar.gz"} {
+		archive := filepath.Join(dir, name)
+		target := filepath.Join(dir, "out-"+name)
+		assert.NoError(t, Archive(source, archive))
+
+		// Assert Unit Test
+		assert.NoError(t, Unarchive(archive, target))
+		info, err := os.Stat(filepath.Join(target, "bin", "tool"))
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0755), info.Mode().Perm(), name)
+		assert.True(t, info.ModTime().Equal(modified), "%v: %v", name, info.ModTime())
+		info, err = os.Stat(filepath.Join(target, "bin"))
+		assert.NoError(t, err)
+		assert.True(t, info.ModTime().Equal(modified), "%v: %v", name, info.ModTime())
+		info, err = os.Stat(filepath.Join(target, "ro"))
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0555), info.Mode().Perm(), name)
+		info, err = os.Stat(filepath.Join(target, "ro", "f"))
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm(), name)
+		for link, expected := range map[string]string{"link": "bin/tool", "dirlink": "bin"} {
+			linked, err := os.Readlink(filepath.Join(target, link))
+			assert.NoError(t, err, name)
+			assert.Equal(t, expected, linked, name)
+		}
+		assert.NoError(t, os.Chmod(filepath.Join(target, "ro"), 0755))
+	}
+
+	// Assert a Symbolic Link pointing outside the Target is Rejected
+	assert.NoError(t, os.Symlink("/etc/passwd", filepath.Join(source, "bin", "passwd")))
+	archive := filepath.Join(dir, "outside.zip")
+	assert.NoError(t, Archive(filepath.Join(source, "bin"), archive))
+	target := filepath.Join(dir, "outside")
+	assert.ErrorContains(t, Unarchive(archive, target), "points outside")
+	_, err := os.Lstat(filepath.Join(target, "passwd"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+// TestUnarchiveOverwrite is a unit test for zip.Unarchive() with the Overwrite and OnConflict UnarchiveOptions
+func TestUnarchiveOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "new", "b.txt": "new"})
+	modified := time.Now().Add(-time.Hour).Truncate(time.Second)
+	assert.NoError(t, os.Chtimes(filepath.Join(source, "a.txt"), modified, modified))
+	assert.NoError(t, os.Chtimes(filepath.Join(source, "b.txt"), modified, modified))
+
+	for _, name := range []string{"overwrite.zip", "overwrite.tar"} {
+		archive := filepath.Join(dir, name)
+		assert.NoError(t, Archive(source, archive))
+		target := filepath.Join(dir, "out-"+name)
+
+		// existing writes a.txt older and b.txt newer than the archive entries
+		existing := func() {
+			writeTestTree(t, target, map[string]string{"a.txt": "old", "b.txt": "old"})
+			older, newer := modified.Add(-time.Hour), modified.Add(time.Hour)
+			assert.NoError(t, os.Chtimes(filepath.Join(target, "a.txt"), older, older))
+			assert.NoError(t, os.Chtimes(filepath.Join(target, "b.txt"), newer, newer))
+		}
+		contents := func() []string {
+			a, err := os.ReadFile(filepath.Join(target, "a.txt"))
+			assert.NoError(t, err)
+			b, err := os.ReadFile(filepath.Join(target, "b.txt"))
+			assert.NoError(t, err)
+			return []string{string(a), string(b)}
+		}
+
+		// Assert Unit Test
+		for policy, expected := range map[OverwritePolicy][]string{
+			OverwriteAlways:  {"new", "new"},
+			OverwriteSkip:    {"old", "old"},
+			OverwriteIfNewer: {"new", "old"},
+		} {
+			existing()
+			assert.NoError(t, Unarchive(archive, target, UnarchiveOptions{Overwrite: policy}), name)
+			assert.Equal(t, expected, contents(), "%v policy %v", name, policy)
+		}
+
+		// Assert OnConflict replaces Overwrite
+		existing()
+		var conflicts []string
+		err := Unarchive(archive, target, UnarchiveOptions{Overwrite: OverwriteFail, OnConflict: func(conflict Conflict) OverwritePolicy {
+			conflicts = append(conflicts, conflict.Name)
+			data, err := os.ReadFile(conflict.Path)
+			assert.NoError(t, err)
+			assert.Equal(t, "old", string(data))
+			if conflict.Name == "b.txt" {
+				return OverwriteAlways
+			}
+			return OverwriteSkip
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a.txt", "b.txt"}, conflicts, name)
+		assert.Equal(t, []string{"old", "new"}, contents(), name)
+
+		// Assert OverwriteFail stops the Extraction
+		existing()
+		assert.ErrorContains(t, Unarchive(archive, target, UnarchiveOptions{Overwrite: OverwriteFail}), "already exists", name)
+		assert.Equal(t, []string{"old", "old"}, contents(), name)
+	}
+}
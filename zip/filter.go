@@ -0,0 +1,49 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"github.com/knowntraveler/gogo/fs"
+)
+
+// archiveFilter decides which paths below the source are added to an archive
+type archiveFilter struct {
+	include    *fs.IgnoreMatcher
+	exclude    *fs.IgnoreMatcher
+	ignoreFile *fs.IgnoreMatcher
+	ignore     *fs.IgnoreMatcher
+}
+
+// newArchiveFilter creates the archiveFilter for ArchiveOptions, loading the IgnoreFile
+func newArchiveFilter(options ArchiveOptions) (*archiveFilter, error) {
+	filter := &archiveFilter{
+		include: fs.NewIgnoreMatcher(options.Include),
+		exclude: fs.NewIgnoreMatcher(options.Exclude),
+		ignore:  options.Ignore,
+	}
+
+	// Load Ignore File
+	if options.IgnoreFile != "" {
+		matcher, err := fs.LoadIgnoreFile(options.IgnoreFile)
+		if err != nil {
+			return nil, err
+		}
+		filter.ignoreFile = matcher
+	}
+
+	return filter, nil
+}
+
+// skip reports whether a path relative to the source is left out of the archive
+func (filter *archiveFilter) skip(rel string, isDir bool) bool {
+	if filter.exclude.Match(rel, isDir) || filter.ignoreFile.Match(rel, isDir) || filter.ignore.Match(rel, isDir) {
+		return true
+	}
+	return !isDir && !filter.include.Empty() && !filter.include.Match(rel, false)
+}
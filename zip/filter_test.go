@@ -0,0 +1,93 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/knowntraveler/gogo/fs"
+	"github.com/stretchr/testify/assert"
+)
+
+// archiveNames returns the sorted entry names of an archive
+func archiveNames(t *testing.T, path string) []string {
+	entries, err := List(path)
+	assert.NoError(t, err)
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TestArchiveFilter is a unit test for zip.Archive() with the Include, Exclude and IgnoreFile ArchiveOptions
+func TestArchiveFilter(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{
+		"main.go":             "package main",
+		"README.md":           "readme",
+		"node_modules/x/y.js": "",
+		".git/HEAD":           "",
+		"build/app.o":         "",
+		"cmd/app/app.go":      "package main",
+		".gitignore":          "build/\n",
+	})
+
+	for _, name := range []string{"out.zip", "out.tar.gz"} {
+		target := filepath.Join(dir, name)
+		err := Archive(source, target, ArchiveOptions{
+			Include:    []string{"*.go"},
+			Exclude:    []string{"node_modules/", ".git/"},
+			IgnoreFile: filepath.Join(source, ".gitignore"),
+		})
+
+		// Assert Unit Test
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"cmd/", "cmd/app/", "cmd/app/app.go", "main.go"}, archiveNames(t, target), name)
+		out := filepath.Join(dir, "out-"+name)
+		assert.NoError(t, Unarchive(target, out))
+		data, err := os.ReadFile(filepath.Join(out, "cmd", "app", "app.go"))
+		assert.NoError(t, err)
+		assert.Equal(t, "package main", string(data))
+	}
+
+	// Assert Exclude wins over Include (Directories are Added unless Excluded)
+	target := filepath.Join(dir, "exclude.zip")
+	assert.NoError(t, Archive(source, target, ArchiveOptions{Include: []string{"*.go"}, Exclude: []string{"cmd/", ".*", "node_modules/"}}))
+	assert.Equal(t, []string{"build/", "main.go"}, archiveNames(t, target))
+}
+
+// TestArchiveIgnore is a unit test for zip.Archive() with ArchiveOptions.Ignore
+func TestArchiveIgnore(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.go": "", "node_modules/x/y.js": "", "sub/b.log": "", "sub/c.txt": ""})
+	target := filepath.Join(dir, "out.zip")
+
+	// Assert Unit Test
+	assert.NoError(t, Archive(source, target, ArchiveOptions{Ignore: fs.NewIgnoreMatcher([]string{"node_modules/", "*.log"})}))
+	assert.Equal(t, []string{"a.go", "sub/", "sub/c.txt"}, archiveNames(t, target))
+}
+
+// TestArchiveFilterErrors is a unit test for zip.Archive() failing with a missing IgnoreFile
+func TestArchiveFilterErrors(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.go": ""})
+	target := filepath.Join(dir, "out.zip")
+
+	// Assert Unit Test
+	assert.Error(t, Archive(source, target, ArchiveOptions{IgnoreFile: filepath.Join(dir, "missing")}))
+	assert.NoFileExists(t, target)
+}
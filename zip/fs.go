@@ -0,0 +1,298 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/knowntraveler/gogo/fs"
+)
+
+// ArchiveFS Function for Zipping an Archive File (.zip) from an io/fs.FS (e.g. embed.FS,
+// os.DirFS or a generated fstest.MapFS) without first writing it to disk, targets with a
+// tar extension are written as tar archives. Use ArchiveSources with Source.FS to add
+// part of an FS or to combine it with other sources.
+func ArchiveFS(fsys iofs.FS, target string, options ...ArchiveOptions) error {
+
+	// Validate Target Parameter
+	if target == "" {
+		return fmt.Errorf("The 'target' parameter was empty. A target is required to create a Zip Archive")
+	}
+
+	// Validate Source Parameter
+	if fsys == nil {
+		return fmt.Errorf("The 'fsys' parameter was nil. A source is required to create a Zip Archive")
+	}
+
+	return archiveSources(context.Background(), []Source{{Path: ".", FS: fsys}}, target, options)
+}
+
+// UnarchiveFS Function for Unzipping an Archive File (.zip, a tar archive, .7z or .rar)
+// into an fs.WriteFS (e.g. an fs.MemFS for tests or for pipelines that process the
+// files in memory) instead of a directory on disk. Entry names must be slash-separated
+// io/fs paths inside the target (absolute and ../ names are rejected even when
+// UnarchiveOptions.Unsafe is set), entries and symbolic links must also resolve inside
+// the target through the symbolic links extracted before them and symbolic links
+// pointing outside the target are handled by UnarchiveOptions.Links. The Progress total is -1 (the bytes extracted are
+// reported as each entry is read).
+func UnarchiveFS(source string, target fs.WriteFS, options ...UnarchiveOptions) error {
+
+	// Validate Source Parameter
+	if source == "" {
+		return fmt.Errorf("The 'source' parameter was empty. A source is required to extract an Archive")
+	}
+
+	// Validate Target Parameter
+	if target == nil {
+		return fmt.Errorf("The 'target' parameter was nil. A target is required to extract an Archive")
+	}
+
+	// Apply Unarchive Options
+	unarchiveOptions := UnarchiveOptions{}
+	if len(options) > 0 {
+		unarchiveOptions = options[0]
+	}
+
+	// Iterate through each Entry found in Source Archive
+	progress := &archiveProgress{onEntry: unarchiveOptions.OnEntry, progress: unarchiveOptions.Progress, entries: -1, size: -1}
+	limits := newExtractLimits(unarchiveOptions)
+	var dirs []extractedDir
+	err := readEntries(context.Background(), source, unarchiveOptions, func(entry archiveEntry) error {
+		progress.entry(entry.Name)
+
+		// Check Extraction Limits
+		err := limits.entry(entry.Name, entry.Size)
+		if err != nil {
+			return err
+		}
+
+		// Set Extracted Name (rejecting entries that escape the target)
+		name, err := fsEntryName(entry.Name)
+		if err == nil && !unarchiveOptions.Unsafe {
+			// Resolve Symbolic Links in the Directory Path (as on disk, for every WriteFS)
+			var dir string
+			dir, err = resolveFSPath(target, path.Dir(name))
+			name = path.Join(dir, path.Base(name))
+		}
+		if err != nil {
+			return fmt.Errorf("Archive entry '%v' is unsafe: %v", entry.Name, err)
+		}
+
+		// Create Directories (permissions and modification times are restored once
+		// all entries are extracted)
+		if entry.Mode.IsDir() {
+			dirs = append(dirs, extractedDir{name, fileMode(entry.Mode, true), entry.Modified})
+			return target.MkdirAll(name, 0755)
+		}
+
+		// Special Files and Links without a Target are not extracted
+		if !entry.Mode.IsRegular() && (entry.Mode&os.ModeSymlink == 0 || entry.Link == "") {
+			return nil
+		}
+
+		// Check Existing Files (directories are merged)
+		if existing, err := target.Lstat(name); err == nil {
+			extract, err := resolveConflict(entry.Name, name, entry.Modified, existing, unarchiveOptions)
+			if err != nil || !extract {
+				return err
+			}
+			if !existing.IsDir() {
+				err = target.Remove(name)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		// Create Directory Path
+		err = target.MkdirAll(path.Dir(name), 0755)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case entry.Mode&os.ModeSymlink != 0:
+			// Recreate Symbolic Link
+			return extractFSLink(target, name, entry.Link, unarchiveOptions)
+		case entry.hardLink != "":
+			// Copy the Contents of the Linked File extracted earlier
+			linked, err := fsEntryName(entry.hardLink)
+			if err != nil {
+				return fmt.Errorf("Hard link '%v' is unsafe: %v", entry.hardLink, err)
+			}
+			contents, err := iofs.ReadFile(target, linked)
+			if err != nil {
+				return err
+			}
+			entry.contents = bytes.NewReader(contents)
+		}
+
+		// Extract Regular File
+		return extractFSFile(target, name, progress.reader(limits.reader(entry.contents)), fileMode(entry.Mode, false), entry.Modified)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Restore Directory Permissions and Modification Times
+	for i := len(dirs) - 1; i >= 0; i-- {
+		err = setFSMetadata(target, dirs[i].path, dirs[i].mode, dirs[i].modified)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fsEntryName returns the io/fs path an archive entry is extracted to in a WriteFS,
+// returning an error if the entry name is absolute or uses ../ to escape the target
+func fsEntryName(name string) (string, error) {
+	clean := path.Clean(strings.ReplaceAll(name, `\`, "/"))
+	if strings.HasPrefix(clean, "/") || !iofs.ValidPath(clean) {
+		return "", fmt.Errorf("Path '%v' is not inside the target", name)
+	}
+	return clean, nil
+}
+
+// extractFSFile writes the contents of an archive entry to a file in a WriteFS,
+// removing the partially written file on failure
+func extractFSFile(target fs.WriteFS, name string, r io.Reader, mode os.FileMode, modified time.Time) error {
+
+	// Create an output file for writing
+	w, err := target.Create(name, mode)
+	if err != nil {
+		return err
+	}
+
+	// Copy Entry Contents
+	_, err = io.Copy(w, r)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		target.Remove(name)
+		return err
+	}
+
+	// Restore Permissions and Modification Time
+	return setFSMetadata(target, name, mode, modified)
+}
+
+// extractFSLink creates a symbolic link entry in a WriteFS, links pointing outside the
+// target are handled by UnarchiveOptions.Links unless UnarchiveOptions.Unsafe is set
+func extractFSLink(target fs.WriteFS, name string, link string, options UnarchiveOptions) error {
+	link = strings.ReplaceAll(link, `\`, "/")
+	absolute := path.IsAbs(link)
+
+	// Check Link Target (relative links must resolve inside the target, including
+	// through the symbolic links extracted earlier)
+	if !options.Unsafe && (absolute || !fsLinkInside(target, name, link)) {
+		switch {
+		case options.Links == LinkSkip:
+			return nil
+		case options.Links == LinkRewrite && absolute:
+			link = fsRelPath(path.Dir(name), strings.TrimPrefix(path.Clean(link), "/"))
+		default:
+			return fmt.Errorf("Symbolic link '%v' points outside the target", link)
+		}
+	}
+
+	return target.Symlink(link, name)
+}
+
+// fsLinkInside reports whether a relative symbolic link at name resolves inside a WriteFS
+func fsLinkInside(target fs.WriteFS, name string, link string) bool {
+	_, err := resolveFSPath(target, path.Dir(name)+"/"+link)
+	return err == nil
+}
+
+// maxFSLinks limits the symbolic links followed when resolving a path in a WriteFS
+const maxFSLinks = 40
+
+// resolveFSPath returns a slash-separated path in a WriteFS with the symbolic links of
+// its existing part resolved (".." is applied after resolving the preceding links),
+// returning an error if the path resolves outside the target
+func resolveFSPath(target fs.WriteFS, name string) (string, error) {
+	resolved := "."
+	rest := strings.Split(name, "/")
+	for links := 0; len(rest) > 0; {
+		next := path.Join(resolved, rest[0])
+		rest = rest[1:]
+		if next == ".." || strings.HasPrefix(next, "../") {
+			return "", fmt.Errorf("Path '%v' is not inside the target", name)
+		}
+
+		// Follow Symbolic Links
+		info, err := target.Lstat(next)
+		if err != nil || info.Mode()&os.ModeSymlink == 0 {
+			resolved = next
+			continue
+		}
+		links++
+		reader, ok := target.(readLinkFS)
+		if !ok || links > maxFSLinks {
+			return "", fmt.Errorf("Path '%v' cannot be resolved through symbolic link '%v'", name, next)
+		}
+		link, err := reader.ReadLink(next)
+		if err != nil {
+			return "", err
+		}
+		if path.IsAbs(link) {
+			return "", fmt.Errorf("Path '%v' is not inside the target", name)
+		}
+		rest = append(strings.Split(link, "/"), rest...)
+	}
+	return resolved, nil
+}
+
+// fsRelPath returns the slash-separated path of name relative to the directory dir,
+// both io/fs paths
+func fsRelPath(dir string, name string) string {
+	if name == "" {
+		name = "."
+	}
+	if dir == "." {
+		return name
+	}
+	if name == "." {
+		name = ""
+	}
+	dirs := strings.Split(dir, "/")
+	names := strings.Split(name, "/")
+	common := 0
+	for common < len(dirs) && common < len(names) && dirs[common] == names[common] {
+		common++
+	}
+	rel := strings.Repeat("../", len(dirs)-common) + strings.Join(names[common:], "/")
+	if rel == "" {
+		return "."
+	}
+	return strings.TrimSuffix(rel, "/")
+}
+
+// setFSMetadata restores the permissions and modification time of a file or directory
+// extracted to a WriteFS
+func setFSMetadata(target fs.WriteFS, name string, mode os.FileMode, modified time.Time) error {
+	err := target.Chmod(name, mode)
+	if err != nil {
+		return err
+	}
+	if modified.IsZero() {
+		return nil
+	}
+	return target.Chtimes(name, modified, modified)
+}
@@ -0,0 +1,198 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"errors"
+	iofs "io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"testing/fstest"
+
+	"github.com/knowntraveler/gogo/fs"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestArchiveFS is a unit test for zip.ArchiveFS()
+func TestArchiveFS(t *testing.T) {
+	dir := t.TempDir()
+	fsys := fstest.MapFS{
+		"a.txt":     {Data: []byte("a")},
+		"sub/b.txt": {Data: []byte("b"), Mode: 0755},
+	}
+
+	for _, name := range []string{"fs.zip", "fs.tar.gz"} {
+		target := filepath.Join(dir, name)
+
+		// Assert Unit Test
+		assert.NoError(t, ArchiveFS(fsys, target))
+		assert.Equal(t, []string{"a.txt", "sub/", "sub/b.txt"}, archiveNames(t, target), name)
+		out := filepath.Join(dir, "out-"+name)
+		assert.NoError(t, Unarchive(target, out))
+		data, err := os.ReadFile(filepath.Join(out, "sub", "b.txt"))
+		assert.NoError(t, err)
+		assert.Equal(t, "b", string(data))
+	}
+
+	// Assert Symbolic Links are Read from an os.DirFS
+	supportsSymlinks(t)
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"f": "f"})
+	assert.NoError(t, os.Symlink("f", filepath.Join(source, "l")))
+	target := filepath.Join(dir, "dirfs.zip")
+	assert.NoError(t, ArchiveFS(os.DirFS(source), target))
+	entries, err := List(target)
+	assert.NoError(t, err)
+	links := map[string]string{}
+	for _, entry := range entries {
+		links[entry.Name] = entry.Link
+	}
+	assert.Equal(t, map[string]string{"f": "", "l": "f"}, links)
+}
+
+// TestArchiveSourcesFS is a unit test for zip.ArchiveSources() combining FS and local Sources
+func TestArchiveSourcesFS(t *testing.T) {
+	dir := t.TempDir()
+	fsys := fstest.MapFS{
+		"tmpl/a.tmpl":     {Data: []byte("A")},
+		"tmpl/sub/b.tmpl": {Data: []byte("B")},
+		"tmpl/dup.tmpl":   {Data: []byte("A")},
+		"other.txt":       {Data: []byte("O")},
+	}
+	writeTestTree(t, dir, map[string]string{"local.txt": "L"})
+	sources := []Source{
+		{Path: "tmpl", FS: fsys, Prefix: "templates"},
+		{Path: "other.txt", FS: fsys},
+		{Path: filepath.Join(dir, "local.txt")},
+	}
+
+	for _, name := range []string{"sources.zip", "sources.tar.gz"} {
+		target := filepath.Join(dir, name)
+
+		// Assert Unit Test
+		assert.NoError(t, ArchiveSources(sources, target, ArchiveOptions{Deduplicate: true, Exclude: []string{"sub/"}}))
+		out := filepath.Join(dir, "out-"+name)
+		assert.NoError(t, Unarchive(target, out))
+		for file, expected := range map[string]string{"templates/a.tmpl": "A", "templates/dup.tmpl": "A", "other.txt": "O", "local.txt": "L"} {
+			data, err := os.ReadFile(filepath.Join(out, file))
+			assert.NoError(t, err, file)
+			assert.Equal(t, expected, string(data), file)
+		}
+		assert.NoDirExists(t, filepath.Join(out, "templates", "sub"))
+	}
+}
+
+// TestArchiveFSErrors is a unit test for zip.ArchiveFS() and zip.ArchiveSources() rejecting invalid FS sources
+func TestArchiveFSErrors(t *testing.T) {
+	dir := t.TempDir()
+	fsys := fstest.MapFS{"a.txt": {Data: []byte("a")}}
+	target := filepath.Join(dir, "bad.zip")
+
+	// Assert Unit Test
+	assert.ErrorContains(t, ArchiveFS(nil, target), "The 'fsys' parameter was nil")
+	assert.ErrorContains(t, ArchiveFS(fsys, ""), "The 'target' parameter was empty")
+	assert.ErrorContains(t, ArchiveSources([]Source{{Path: "../x", FS: fsys}}, target), "is not a valid io/fs path")
+	assert.ErrorContains(t, ArchiveSources([]Source{{Path: "/a.txt", FS: fsys}}, target), "is not a valid io/fs path")
+	assert.Error(t, ArchiveSources([]Source{{Path: "missing", FS: fsys}}, target))
+	assert.NoFileExists(t, target)
+}
+
+// TestUnarchiveFS is a unit test for zip.UnarchiveFS()
+func TestUnarchiveFS(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a/b/f.txt": "hello", "dup.txt": "hello"})
+	if runtime.GOOS != "windows" {
+		assert.NoError(t, os.Chmod(filepath.Join(source, "a", "b", "f.txt"), 0600))
+	}
+
+	for _, ext := range []string{".zip", ".tar.gz", ".tar"} {
+		archive := filepath.Join(dir, "x"+ext)
+		assert.NoError(t, Archive(source, archive, ArchiveOptions{Deduplicate: true}))
+		memfs := fs.NewMemFS()
+		recorder := &progressRecorder{}
+
+		// Assert Unit Test
+		assert.NoError(t, UnarchiveFS(archive, memfs, UnarchiveOptions{OnEntry: recorder.onEntry, Progress: recorder.progress}), ext)
+		for _, name := range []string{"a/b/f.txt", "dup.txt"} {
+			data, err := memfs.ReadFile(name)
+			assert.NoError(t, err, ext, name)
+			assert.Equal(t, "hello", string(data), ext, name)
+		}
+		assert.ElementsMatch(t, []string{"a/", "a/b/", "a/b/f.txt", "dup.txt"}, recorder.names, ext)
+		assert.Equal(t, int64(-1), recorder.size, ext)
+		if runtime.GOOS != "windows" {
+			info, err := memfs.Stat("a/b/f.txt")
+			assert.NoError(t, err)
+			assert.Equal(t, os.FileMode(0600), info.Mode().Perm(), ext)
+		}
+
+		// Assert the Overwrite Policy and Extraction Limits
+		assert.ErrorContains(t, UnarchiveFS(archive, memfs, UnarchiveOptions{Overwrite: OverwriteFail}), "already exists", ext)
+		assert.NoError(t, UnarchiveFS(archive, memfs), ext)
+		assert.True(t, errors.Is(UnarchiveFS(archive, fs.NewMemFS(), UnarchiveOptions{MaxEntrySize: 3}), ErrLimitExceeded), ext)
+	}
+
+	// Assert RAR and 7z Archives
+	memfs := fs.NewMemFS()
+	assert.NoError(t, UnarchiveFS(filepath.Join("testdata", "stored.rar"), memfs))
+	data, err := memfs.ReadFile("readme.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello from rar", string(data))
+	memfs = fs.NewMemFS()
+	assert.NoError(t, UnarchiveFS(filepath.Join("testdata", "bsdtar.7z"), memfs))
+	data, err = memfs.ReadFile("a.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "hi\n", string(data))
+	link, err := memfs.ReadLink("link")
+	assert.NoError(t, err)
+	assert.Equal(t, "a.txt", link)
+}
+
+// TestUnarchiveFSErrors is a unit test for zip.UnarchiveFS() rejecting unsafe entries
+func TestUnarchiveFSErrors(t *testing.T) {
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "links.tar")
+	writeTestTar(t, archive, testEntry{name: "a.txt", body: "hello"}, testEntry{name: "evil", link: "/etc/passwd"})
+
+	// Assert Unit Test
+	assert.ErrorContains(t, UnarchiveFS(archive, fs.NewMemFS()), "points outside")
+	memfs := fs.NewMemFS()
+	assert.NoError(t, UnarchiveFS(archive, memfs, UnarchiveOptions{Links: LinkSkip}))
+	_, err := memfs.Lstat("evil")
+	assert.True(t, errors.Is(err, iofs.ErrNotExist), err)
+	memfs = fs.NewMemFS()
+	assert.NoError(t, UnarchiveFS(archive, memfs, UnarchiveOptions{Links: LinkRewrite}))
+	link, err := memfs.ReadLink("evil")
+	assert.NoError(t, err)
+	assert.Equal(t, "etc/passwd", link)
+	assert.Error(t, UnarchiveFS(filepath.Join("testdata", "unsafe.rar"), fs.NewMemFS()))
+
+	// Assert Chained Symbolic Links are Contained
+	writeTestTar(t, archive, testEntry{name: "a/b/l", link: "../.."}, testEntry{name: "l2", link: "a/b/l/.."}, testEntry{name: "l2/evil", body: "x"})
+	assert.ErrorContains(t, UnarchiveFS(archive, fs.NewMemFS()), "Symbolic link 'a/b/l/..' points outside")
+	writeTestTar(t, archive, testEntry{name: "a", link: "b/.."}, testEntry{name: "b", link: "."}, testEntry{name: "a/evil", body: "x"})
+	assert.ErrorContains(t, UnarchiveFS(archive, fs.NewMemFS()), "Archive entry 'a/evil' is unsafe")
+	writeTestTar(t, archive, testEntry{name: "share/doc/README", body: "hello"}, testEntry{name: "docs", link: "share/doc"},
+		testEntry{name: "readme", link: "docs/README"}, testEntry{name: "docs/NOTES", body: "notes"})
+	memfs = fs.NewMemFS()
+	assert.NoError(t, UnarchiveFS(archive, memfs))
+	resolved, err := resolveFSPath(memfs, "readme")
+	assert.NoError(t, err)
+	assert.Equal(t, "share/doc/README", resolved)
+	data, err := iofs.ReadFile(memfs, "share/doc/NOTES")
+	assert.NoError(t, err)
+	assert.Equal(t, "notes", string(data))
+
+	// Assert Invalid Parameters
+	assert.ErrorContains(t, UnarchiveFS("", fs.NewMemFS()), "The 'source' parameter was empty")
+	assert.ErrorContains(t, UnarchiveFS(archive, nil), "The 'target' parameter was nil")
+}
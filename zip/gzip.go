@@ -0,0 +1,141 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GzipFile Function for Compressing a single File to a Gzip File (.gz), streaming the
+// source so files of any size can be compressed. An empty target defaults to the
+// source with a .gz extension. The source file name and modification time are
+// stored in the gzip header.
+func GzipFile(source string, target string, options ...GzipOptions) error {
+
+	// Apply Gzip Options
+	gzipOptions := GzipOptions{}
+	if len(options) > 0 {
+		gzipOptions = options[0]
+	}
+
+	// Validate Source Parameter
+	if source == "" {
+		return fmt.Errorf("The 'source' parameter was empty. A source is required to create a Gzip File")
+	}
+	if target == "" {
+		target = source + ".gz"
+	}
+
+	// Open Source File
+	file, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("File '%v' is not a regular file", source)
+	}
+
+	// Create Gzip File
+	out, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+
+	// Compress Source File (removing the partial target on failure)
+	err = writeGzip(out, file, info, gzipOptions.Level)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(target)
+		return err
+	}
+
+	return nil
+}
+
+// writeGzip compresses a source file to a writer
+func writeGzip(w io.Writer, source io.Reader, info os.FileInfo, level CompressionLevel) error {
+	writer, err := gzip.NewWriterLevel(w, level.flate())
+	if err != nil {
+		return err
+	}
+	writer.Name = info.Name()
+	writer.ModTime = info.ModTime()
+
+	_, err = io.Copy(writer, source)
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// GunzipFile Function for Decompressing a Gzip File (.gz) to a single File, streaming
+// the source so files of any size can be decompressed. An empty target defaults to
+// the source without its .gz extension. The modification time stored in the gzip
+// header is restored.
+func GunzipFile(source string, target string) error {
+
+	// Validate Source Parameter
+	if source == "" {
+		return fmt.Errorf("The 'source' parameter was empty. A source is required to decompress a Gzip File")
+	}
+	if target == "" {
+		if !strings.EqualFold(filepath.Ext(source), ".gz") {
+			return fmt.Errorf("The 'target' parameter was empty and source '%v' has no .gz extension", source)
+		}
+		target = source[:len(source)-len(".gz")]
+	}
+
+	// Open Source File
+	file, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return fmt.Errorf("File '%v' is not a gzip file: %v", source, err)
+	}
+	defer reader.Close()
+
+	// Create Target File
+	out, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+
+	// Decompress Source File (removing the partial target on failure)
+	_, err = io.Copy(out, reader)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(target)
+		return err
+	}
+
+	// Set File Modification Time
+	if !reader.ModTime.IsZero() {
+		return os.Chtimes(target, reader.ModTime, reader.ModTime)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGzipFile is a unit test for zip.GzipFile() and zip.GunzipFile()
+func TestGzipFile(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "app.log")
+	data := bytes.Repeat([]byte("log line\n"), 1000)
+	assert.NoError(t, os.WriteFile(source, data, 0644))
+	modTime := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.NoError(t, os.Chtimes(source, modTime, modTime))
+
+	sizes := map[CompressionLevel]int64{}
+	for _, level := range []CompressionLevel{DefaultCompression, Store, Fastest, BestCompression} {
+		assert.NoError(t, GzipFile(source, "", GzipOptions{Level: level}))
+
+		// Assert the Gzip Header records the Source
+		file, err := os.Open(source + ".gz")
+		assert.NoError(t, err)
+		reader, err := gzip.NewReader(file)
+		assert.NoError(t, err)
+		assert.Equal(t, "app.log", reader.Name)
+		assert.True(t, reader.ModTime.Equal(modTime))
+		reader.Close()
+		info, err := file.Stat()
+		assert.NoError(t, err)
+		sizes[level] = info.Size()
+		file.Close()
+
+		// Assert Unit Test
+		assert.NoError(t, os.Remove(source))
+		assert.NoError(t, GunzipFile(source+".gz", ""))
+		restored, err := os.ReadFile(source)
+		assert.NoError(t, err)
+		assert.Equal(t, data, restored)
+		info, err = os.Stat(source)
+		assert.NoError(t, err)
+		assert.True(t, info.ModTime().Equal(modTime), level)
+	}
+	assert.Greater(t, sizes[Store], int64(len(data)))
+	assert.Less(t, sizes[BestCompression], sizes[Store])
+
+	// Assert an Explicit Target
+	target := filepath.Join(dir, "copy.txt")
+	assert.NoError(t, GunzipFile(source+".gz", target))
+	assert.FileExists(t, target)
+}
+
+// TestGzipFileErrors is a unit test for zip.GzipFile() and zip.GunzipFile() failing
+func TestGzipFileErrors(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "plain.txt")
+	assert.NoError(t, os.WriteFile(source, []byte("not gzip"), 0644))
+
+	// Assert Unit Test
+	assert.ErrorContains(t, GzipFile("", ""), "The 'source' parameter was empty")
+	assert.ErrorContains(t, GzipFile(dir, ""), "is not a regular file")
+	assert.NoFileExists(t, dir+".gz")
+	assert.ErrorContains(t, GunzipFile(source, ""), "has no .gz extension")
+	target := filepath.Join(dir, "out.txt")
+	assert.ErrorContains(t, GunzipFile(source, target), "is not a gzip file")
+	assert.NoFileExists(t, target)
+
+	// Assert a Truncated Gzip File leaves no Partial Target
+	assert.NoError(t, GzipFile(source, ""))
+	data, err := os.ReadFile(source + ".gz")
+	assert.NoError(t, err)
+	truncated := filepath.Join(dir, "truncated.txt.gz")
+	assert.NoError(t, os.WriteFile(truncated, data[:len(data)-6], 0644))
+	assert.Error(t, GunzipFile(truncated, ""))
+	assert.NoFileExists(t, filepath.Join(dir, "truncated.txt"))
+}
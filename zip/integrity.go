@@ -0,0 +1,138 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"fmt"
+	"io"
+)
+
+// Verify Function for Checking the Integrity of an Archive File (.zip or a tar archive)
+// without extracting it. Every entry of a zip archive is decompressed and checked
+// against its CRC32, tar archives are checked by their header checksums and the
+// checksum of the compression format. With VerifyOptions.Manifest the file entries are
+// also checked against a checksum manifest. Nothing is written to disk.
+func Verify(source string, options ...VerifyOptions) error {
+
+	// Validate Source Parameter
+	if source == "" {
+		return fmt.Errorf("The 'source' parameter was empty. A source is required to verify an Archive")
+	}
+
+	// Apply Verify Options
+	verifyOptions := VerifyOptions{}
+	if len(options) > 0 {
+		verifyOptions = options[0]
+	}
+
+	// Verify Tar Archives
+	if compressor, ok := tarFormat(source); ok {
+		err := verifyTar(source, compressor)
+		if err != nil || verifyOptions.Manifest == "" {
+			return err
+		}
+		return verifyManifest(source, verifyOptions.Manifest, verifyOptions.Password)
+	}
+
+	// Read Central Directory
+	zipReader, closer, err := openZipArchive(source)
+	if err != nil {
+		return fmt.Errorf("Archive '%v' is corrupt: %w", source, err)
+	}
+	defer closer.Close()
+
+	// Check each Entry
+	names := map[string]bool{}
+	for _, file := range zipReader.File {
+		if names[file.Name] {
+			return fmt.Errorf("Archive '%v' has more than one entry named '%v'", source, file.Name)
+		}
+		names[file.Name] = true
+
+		err = verifyZipFile(file, verifyOptions.Password)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check Entries against the Checksum Manifest
+	if verifyOptions.Manifest != "" {
+		return verifyManifest(source, verifyOptions.Manifest, verifyOptions.Password)
+	}
+
+	return nil
+}
+
+// verifyZipFile decompresses a zip entry, checking its local header, size and CRC32
+// (encrypted entries are decrypted with the password and authenticated)
+func verifyZipFile(file *zip.File, password string) error {
+	contents, err := openZipFile(file, password)
+	if err != nil {
+		if file.Flags&0x1 != 0 {
+			return err
+		}
+		return fmt.Errorf("Archive entry '%v' is corrupt: %w", file.Name, err)
+	}
+	defer contents.Close()
+
+	_, err = io.Copy(io.Discard, contents)
+	if err != nil {
+		return fmt.Errorf("Archive entry '%v' is corrupt: %w", file.Name, err)
+	}
+	return nil
+}
+
+// verifyTar reads every entry of a tar archive, decompressed with the Compressor
+// unless it is nil
+func verifyTar(source string, compressor Compressor) error {
+
+	// Open Source Archive (joining the parts of a split archive)
+	file, _, err := openArchiveFile(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Create Decompressed Reader
+	var reader io.Reader = file
+	if compressor != nil {
+		decompressed, err := compressor.Reader(file)
+		if err != nil {
+			return fmt.Errorf("Archive '%v' is corrupt: %w", source, err)
+		}
+		defer decompressed.Close()
+		reader = decompressed
+	}
+
+	// Read each Entry
+	archive := tar.NewReader(reader)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("Archive '%v' is corrupt: %w", source, err)
+		}
+		_, err = io.Copy(io.Discard, archive)
+		if err != nil {
+			return fmt.Errorf("Archive entry '%v' is corrupt: %w", header.Name, err)
+		}
+	}
+
+	// Read to the End of the Compressed Stream (checking its checksum)
+	_, err = io.Copy(io.Discard, reader)
+	if err != nil {
+		return fmt.Errorf("Archive '%v' is corrupt: %w", source, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestVerify is a unit test for zip.Verify()
+func TestVerify(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{
+		"a.txt": strings.Repeat("verify me ", 1000),
+		"c.png": "stored contents here png",
+	})
+
+	for _, ext := range []string{".zip", ".tar", ".tar.gz", ".tar.zst", ".tar.xz"} {
+		archive := filepath.Join(dir, "verify"+ext)
+		assert.NoError(t, Archive(source, archive))
+
+		// Assert Unit Test
+		assert.NoError(t, Verify(archive), ext)
+
+		// Assert a Flipped Byte is Detected (a plain tar has no checksum of its contents)
+		data, err := os.ReadFile(archive)
+		assert.NoError(t, err)
+		index := len(data) / 2
+		if ext == ".zip" || ext == ".tar" {
+			index = bytes.Index(data, []byte("stored contents here png")) + 3
+		}
+		data[index] ^= 0x55
+		corrupt := filepath.Join(dir, "corrupt"+ext)
+		assert.NoError(t, os.WriteFile(corrupt, data, 0644))
+		if ext == ".tar" {
+			assert.NoError(t, Verify(corrupt))
+		} else {
+			assert.ErrorContains(t, Verify(corrupt), "is corrupt", ext)
+		}
+	}
+}
+
+// TestVerifyErrors is a unit test for zip.Verify() failing on encrypted, truncated and invalid archives
+func TestVerifyErrors(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": strings.Repeat("verify me ", 1000)})
+
+	// Assert Encrypted Archives require the Password
+	archive := filepath.Join(dir, "encrypted.zip")
+	assert.NoError(t, Archive(source, archive, ArchiveOptions{Password: "pw"}))
+	assert.ErrorContains(t, Verify(archive), "password is required")
+	assert.ErrorContains(t, Verify(archive, VerifyOptions{Password: "x"}), "is incorrect")
+	assert.NoError(t, Verify(archive, VerifyOptions{Password: "pw"}))
+
+	// Assert Unit Test
+	assert.ErrorContains(t, Verify(""), "The 'source' parameter was empty")
+	junk := filepath.Join(dir, "junk.zip")
+	assert.NoError(t, os.WriteFile(junk, []byte("junk"), 0644))
+	assert.ErrorContains(t, Verify(junk), "is corrupt")
+	archive = filepath.Join(dir, "truncated.tar.gz")
+	assert.NoError(t, Archive(source, archive))
+	data, err := os.ReadFile(archive)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(archive, data[:len(data)-10], 0644))
+	assert.ErrorContains(t, Verify(archive), "is corrupt")
+
+	// Assert Duplicate Entries are Rejected
+	archive = filepath.Join(dir, "duplicate.zip")
+	writeTestZip(t, archive, testEntry{name: "a.txt", body: "one"}, testEntry{name: "a.txt", body: "two"})
+	assert.ErrorContains(t, Verify(archive), "has more than one entry named 'a.txt'")
+}
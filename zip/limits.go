@@ -0,0 +1,117 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Default Extraction Limits (applied to untrusted archives, see UnarchiveOptions),
+// sized to stop a decompression bomb before it fills the disk of a typical host while
+// extracting ordinary release artifacts. Raise them in UnarchiveOptions for larger
+// archives (e.g. disk images or datasets).
+const (
+	DefaultMaxSize      = 4 << 30 // 4 GiB extracted in total
+	DefaultMaxEntries   = 1000000 // entries in an archive
+	DefaultMaxEntrySize = 2 << 30 // 2 GiB extracted from a single entry
+)
+
+// ErrLimitExceeded is returned by Unarchive when an archive exceeds one of the
+// extraction limits of UnarchiveOptions (e.g. a decompression bomb)
+var ErrLimitExceeded = errors.New("extraction limit exceeded")
+
+// extractLimits enforces the MaxSize, MaxEntries and MaxEntrySize of UnarchiveOptions,
+// counting the bytes actually extracted since archive headers may understate sizes
+type extractLimits struct {
+	maxSize      int64
+	maxEntries   int64
+	maxEntrySize int64
+	name         string
+	entries      int64
+	size         int64
+	entrySize    int64
+}
+
+// newExtractLimits returns the extractLimits of UnarchiveOptions, zero values use the
+// default limits unless UnarchiveOptions.Unsafe is set and negative values disable a limit
+func newExtractLimits(options UnarchiveOptions) *extractLimits {
+	limit := func(value int64, fallback int64) int64 {
+		switch {
+		case value < 0:
+			return -1
+		case value == 0 && options.Unsafe:
+			return -1
+		case value == 0:
+			return fallback
+		}
+		return value
+	}
+	return &extractLimits{
+		maxSize:      limit(options.MaxSize, DefaultMaxSize),
+		maxEntries:   limit(int64(options.MaxEntries), DefaultMaxEntries),
+		maxEntrySize: limit(options.MaxEntrySize, DefaultMaxEntrySize),
+	}
+}
+
+// check fails when the entry count or the total size recorded by an archive index
+// (-1 when unknown) exceed the limits, before anything is extracted
+func (l *extractLimits) check(entries int, size int64) error {
+	if l.maxEntries >= 0 && int64(entries) > l.maxEntries {
+		return fmt.Errorf("Archive has %d entries, more than the maximum of %d: %w", entries, l.maxEntries, ErrLimitExceeded)
+	}
+	if l.maxSize >= 0 && size > l.maxSize {
+		return fmt.Errorf("Archive contents of %d bytes exceed the maximum size of %d bytes: %w", size, l.maxSize, ErrLimitExceeded)
+	}
+	return nil
+}
+
+// entry counts the next entry and checks the size recorded by its header (-1 when unknown)
+func (l *extractLimits) entry(name string, size int64) error {
+	l.name, l.entrySize = name, 0
+	l.entries++
+	if l.maxEntries >= 0 && l.entries > l.maxEntries {
+		return fmt.Errorf("Archive has more than the maximum of %d entries: %w", l.maxEntries, ErrLimitExceeded)
+	}
+	if l.maxEntrySize >= 0 && size > l.maxEntrySize {
+		return fmt.Errorf("Archive entry '%v' of %d bytes exceeds the maximum entry size of %d bytes: %w", name, size, l.maxEntrySize, ErrLimitExceeded)
+	}
+	return nil
+}
+
+// reader returns a reader failing once the bytes read exceed the limits of the current
+// entry or the archive, a nil extractLimits doesn't limit the reader
+func (l *extractLimits) reader(r io.Reader) io.Reader {
+	if l == nil {
+		return r
+	}
+	return &limitedReader{reader: r, limits: l}
+}
+
+// limitedReader is an io.Reader adding the bytes read to extractLimits
+type limitedReader struct {
+	reader io.Reader
+	limits *extractLimits
+}
+
+// Read reads from the underlying reader and checks the limits
+func (r *limitedReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	l := r.limits
+	l.size += int64(n)
+	l.entrySize += int64(n)
+	if l.maxEntrySize >= 0 && l.entrySize > l.maxEntrySize {
+		return n, fmt.Errorf("Archive entry '%v' exceeds the maximum entry size of %d bytes: %w", l.name, l.maxEntrySize, ErrLimitExceeded)
+	}
+	if l.maxSize >= 0 && l.size > l.maxSize {
+		return n, fmt.Errorf("Archive contents exceed the maximum size of %d bytes: %w", l.maxSize, ErrLimitExceeded)
+	}
+	return n, err
+}
@@ -0,0 +1,149 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// bombTree writes a source tree of files of zeros (compressing to almost nothing)
+func bombTree(t *testing.T, dir string, sizes map[string]int) string {
+	source := filepath.Join(dir, "src")
+	assert.NoError(t, os.MkdirAll(source, 0755))
+	for name, size := range sizes {
+		assert.NoError(t, os.WriteFile(filepath.Join(source, name), make([]byte, size), 0644))
+	}
+	return source
+}
+
+// understateSize rewrites the uncompressed size recorded for an entry in the central
+// directory of a zip archive, as a malicious archive would
+func understateSize(t *testing.T, path string, name string, size uint32) {
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	header := bytes.Index(data, []byte("PK\x01\x02"))
+	for header >= 0 {
+		nameSize := int(binary.LittleEndian.Uint16(data[header+28:]))
+		if string(data[header+46:header+46+nameSize]) == name {
+			binary.LittleEndian.PutUint32(data[header+24:], size)
+			assert.NoError(t, os.WriteFile(path, data, 0644))
+			return
+		}
+		next := bytes.Index(data[header+4:], []byte("PK\x01\x02"))
+		if next < 0 {
+			break
+		}
+		header += 4 + next
+	}
+	t.Fatalf("entry %v not found in %v", name, path)
+}
+
+// TestExtractLimits is a unit test for the default, disabled and Unsafe extraction limits
+func TestExtractLimits(t *testing.T) {
+	limits := newExtractLimits(UnarchiveOptions{})
+	assert.Equal(t, int64(DefaultMaxSize), limits.maxSize)
+	assert.Equal(t, int64(DefaultMaxEntries), limits.maxEntries)
+	assert.Equal(t, int64(DefaultMaxEntrySize), limits.maxEntrySize)
+
+	limits = newExtractLimits(UnarchiveOptions{MaxSize: -1, MaxEntries: 5, Unsafe: true})
+	assert.Equal(t, int64(-1), limits.maxSize)
+	assert.Equal(t, int64(5), limits.maxEntries)
+	assert.Equal(t, int64(-1), limits.maxEntrySize)
+
+	// Assert Bytes beyond the Size in the Header are Counted
+	limits = newExtractLimits(UnarchiveOptions{MaxEntrySize: 10})
+	assert.NoError(t, limits.entry("understated", 5))
+	_, err := limits.reader(bytes.NewReader(make([]byte, 20))).Read(make([]byte, 20))
+	assert.True(t, errors.Is(err, ErrLimitExceeded), err)
+}
+
+// TestLimitsMaxEntries is a unit test for zip.Unarchive() with UnarchiveOptions.MaxEntries
+func TestLimitsMaxEntries(t *testing.T) {
+	dir := t.TempDir()
+	source := bombTree(t, dir, map[string]int{"a": 1, "b": 1, "c": 1})
+
+	for _, name := range []string{"many.zip", "many.tar.gz"} {
+		archive := filepath.Join(dir, name)
+		target := filepath.Join(dir, "out-"+name)
+		assert.NoError(t, Archive(source, archive))
+
+		// Assert Unit Test
+		err := Unarchive(archive, target, UnarchiveOptions{MaxEntries: 2})
+		assert.True(t, errors.Is(err, ErrLimitExceeded), "%v: %v", name, err)
+		assert.NoFileExists(t, filepath.Join(target, "c"))
+		assert.NoError(t, Unarchive(archive, filepath.Join(dir, "all-"+name), UnarchiveOptions{MaxEntries: 3}))
+	}
+}
+
+// TestLimitsMaxEntrySize is a unit test for zip.Unarchive() with UnarchiveOptions.MaxEntrySize
+func TestLimitsMaxEntrySize(t *testing.T) {
+	dir := t.TempDir()
+	source := bombTree(t, dir, map[string]int{"zeros": 4 << 20})
+
+	for _, name := range []string{"bomb.zip", "bomb.tar.gz"} {
+		archive := filepath.Join(dir, name)
+		target := filepath.Join(dir, "out-"+name)
+		assert.NoError(t, Archive(source, archive))
+
+		// Assert Unit Test
+		err := Unarchive(archive, target, UnarchiveOptions{MaxEntrySize: 1 << 20})
+		assert.True(t, errors.Is(err, ErrLimitExceeded), "%v: %v", name, err)
+		assert.NoFileExists(t, filepath.Join(target, "zeros"))
+		_, err = ReadEntry(archive, "zeros", UnarchiveOptions{MaxEntrySize: 1 << 20})
+		assert.True(t, errors.Is(err, ErrLimitExceeded), "%v: %v", name, err)
+	}
+
+	// Assert an Entry whose Header understates its Size is stopped while Streamed
+	// (encrypted entries are read raw, so only the extracted bytes are counted)
+	archive := filepath.Join(dir, "understated.zip")
+	assert.NoError(t, Archive(source, archive, ArchiveOptions{Password: "bomb"}))
+	understateSize(t, archive, "zeros", 100)
+	entries, err := List(archive)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), entries[0].Size)
+	target := filepath.Join(dir, "out-understated")
+	err = Unarchive(archive, target, UnarchiveOptions{Password: "bomb", MaxEntrySize: 1 << 20})
+	assert.True(t, errors.Is(err, ErrLimitExceeded), err)
+	assert.ErrorContains(t, err, "exceeds the maximum entry size")
+	assert.NoFileExists(t, filepath.Join(target, "zeros"))
+}
+
+// TestLimitsMaxSize is a unit test for zip.Unarchive() with UnarchiveOptions.MaxSize
+func TestLimitsMaxSize(t *testing.T) {
+	dir := t.TempDir()
+	source := bombTree(t, dir, map[string]int{"a": 3 << 20, "b": 3 << 20})
+
+	for _, name := range []string{"bomb.zip", "bomb.tar.gz"} {
+		archive := filepath.Join(dir, name)
+		target := filepath.Join(dir, "out-"+name)
+		assert.NoError(t, Archive(source, archive))
+
+		// Assert Unit Test
+		err := Unarchive(archive, target, UnarchiveOptions{MaxSize: 5 << 20})
+		assert.True(t, errors.Is(err, ErrLimitExceeded), "%v: %v", name, err)
+		assert.NoFileExists(t, filepath.Join(target, "b"))
+	}
+
+	// Assert the Streamed Total is Limited when Headers understate Sizes
+	archive := filepath.Join(dir, "understated.zip")
+	assert.NoError(t, Archive(source, archive, ArchiveOptions{Password: "bomb"}))
+	understateSize(t, archive, "a", 100)
+	understateSize(t, archive, "b", 100)
+	target := filepath.Join(dir, "out-understated")
+	err := Unarchive(archive, target, UnarchiveOptions{Password: "bomb", MaxSize: 5 << 20})
+	assert.True(t, errors.Is(err, ErrLimitExceeded), err)
+	assert.NoFileExists(t, filepath.Join(target, "b"))
+}
@@ -0,0 +1,342 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Entry is a file, directory or symbolic link in an archive
+type Entry struct {
+	// Name is the slash-separated name of the entry
+	Name string
+
+	// Size is the uncompressed size of the entry contents
+	Size int64
+
+	// Mode is the file mode and permissions of the entry
+	Mode os.FileMode
+
+	// Modified is the modification time of the entry
+	Modified time.Time
+
+	// Link is the target of a symbolic link entry (RAR archives don't report link targets)
+	Link string
+}
+
+// List Function for Listing the entries of an Archive File (.zip, a tar archive, .7z or
+// .rar) without extracting it. The Password from UnarchiveOptions opens 7z and RAR
+// archives with encrypted headers.
+func List(source string, options ...UnarchiveOptions) ([]Entry, error) {
+
+	// Validate Source Parameter
+	if source == "" {
+		return nil, fmt.Errorf("The 'source' parameter was empty. A source is required to list an Archive")
+	}
+
+	// Apply Unarchive Options
+	unarchiveOptions := UnarchiveOptions{}
+	if len(options) > 0 {
+		unarchiveOptions = options[0]
+	}
+
+	// List by Archive Format
+	switch {
+	case isSevenZip(source):
+		return listSevenZip(source, unarchiveOptions.Password)
+	case isRar(source):
+		return listRar(source, unarchiveOptions.Password)
+	}
+	if compressor, ok := tarFormat(source); ok {
+		return listTar(source, compressor)
+	}
+	return listZip(source, unarchiveOptions.Password)
+}
+
+// listZip returns the entries of a zip archive, reading the targets of symbolic links
+// (decrypted with the password if set)
+func listZip(source string, password string) ([]Entry, error) {
+	zipReader, closer, err := openZipArchive(source)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	entries := make([]Entry, 0, len(zipReader.File))
+	for _, file := range zipReader.File {
+		entry := Entry{
+			Name:     file.Name,
+			Size:     int64(file.UncompressedSize64),
+			Mode:     file.Mode(),
+			Modified: file.Modified,
+		}
+		if entry.Mode&os.ModeSymlink != 0 {
+			entry.Link, err = readZipLink(file, password)
+			if err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// listTar returns the entries of a tar archive, decompressed with the Compressor unless it is nil
+func listTar(source string, compressor Compressor) ([]Entry, error) {
+
+	// Open Source Archive (joining the parts of a split archive)
+	file, _, err := openArchiveFile(source)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	// Create Decompressed Reader
+	var reader io.Reader = file
+	if compressor != nil {
+		decompressed, err := compressor.Reader(file)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to read %v archive '%v': %v", compressor.Name(), source, err)
+		}
+		defer decompressed.Close()
+		reader = decompressed
+	}
+
+	// Read each Entry Header
+	archive := tar.NewReader(reader)
+	var entries []Entry
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, Entry{
+			Name:     header.Name,
+			Size:     header.Size,
+			Mode:     header.FileInfo().Mode(),
+			Modified: header.ModTime,
+			Link:     header.Linkname,
+		})
+	}
+}
+
+// archiveEntry is an archive entry passed to the callback of readEntries
+type archiveEntry struct {
+	Entry
+
+	// hardLink is the name of the earlier entry a tar hard link entry links to
+	hardLink string
+
+	// contents reads the contents of a regular file entry (nil for other entries)
+	contents io.Reader
+}
+
+// readEntries calls fn with each entry of an Archive File (.zip, a tar archive, .7z or
+// .rar) in archive order, the contents of an entry can only be read during the call.
+// The Password from UnarchiveOptions decrypts encrypted entries and archives.
+func readEntries(ctx context.Context, source string, options UnarchiveOptions, fn func(entry archiveEntry) error) error {
+	switch {
+	case isSevenZip(source):
+		return readSevenZipEntries(ctx, source, options.Password, fn)
+	case isRar(source):
+		return readRarEntries(ctx, source, options.Password, fn)
+	}
+	if compressor, ok := tarFormat(source); ok {
+		return readTarEntries(ctx, source, compressor, fn)
+	}
+	return readZipEntries(ctx, source, options.Password, fn)
+}
+
+// readZipEntries calls fn with each entry of a zip archive
+func readZipEntries(ctx context.Context, source string, password string, fn func(entry archiveEntry) error) error {
+	zipReader, closer, err := openZipArchive(source)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	for _, file := range zipReader.File {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		err = func() error {
+			entry := archiveEntry{Entry: Entry{
+				Name:     file.Name,
+				Size:     int64(file.UncompressedSize64),
+				Mode:     file.Mode(),
+				Modified: file.Modified,
+			}}
+			switch {
+			case entry.Mode&os.ModeSymlink != 0:
+				link, err := readZipLink(file, password)
+				if err != nil {
+					return err
+				}
+				entry.Link = link
+			case entry.Mode.IsRegular():
+				contents, err := openZipFile(file, password)
+				if err != nil {
+					return err
+				}
+				defer contents.Close()
+				entry.contents = contextReader{ctx, contents}
+			}
+			return fn(entry)
+		}()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readTarEntries calls fn with each entry of a tar archive, decompressed with the
+// Compressor unless it is nil
+func readTarEntries(ctx context.Context, source string, compressor Compressor, fn func(entry archiveEntry) error) error {
+
+	// Open Source Archive (joining the parts of a split archive)
+	file, _, err := openArchiveFile(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Create Decompressed Reader
+	var reader io.Reader = file
+	if compressor != nil {
+		decompressed, err := compressor.Reader(file)
+		if err != nil {
+			return fmt.Errorf("Unable to read %v archive '%v': %v", compressor.Name(), source, err)
+		}
+		defer decompressed.Close()
+		reader = decompressed
+	}
+
+	// Read each Entry
+	archive := tar.NewReader(reader)
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		header, err := archive.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entry := archiveEntry{Entry: Entry{
+			Name:     header.Name,
+			Size:     header.Size,
+			Mode:     header.FileInfo().Mode(),
+			Modified: header.ModTime,
+		}}
+		switch header.Typeflag {
+		case tar.TypeSymlink:
+			entry.Link = header.Linkname
+		case tar.TypeLink:
+			entry.hardLink = header.Linkname
+		case tar.TypeReg:
+			entry.contents = contextReader{ctx, archive}
+		}
+		err = fn(entry)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// readSevenZipEntries calls fn with each entry of a 7z archive
+func readSevenZipEntries(ctx context.Context, source string, password string, fn func(entry archiveEntry) error) error {
+	archive, err := openSevenZip(source, password)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	for _, file := range archive.File {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		err = func() error {
+			entry := archiveEntry{Entry: Entry{
+				Name:     file.Name,
+				Size:     int64(file.UncompressedSize),
+				Mode:     file.Mode(),
+				Modified: file.Modified,
+			}}
+			switch {
+			case entry.Mode&os.ModeSymlink != 0:
+				link, err := readSevenZipLink(file)
+				if err != nil {
+					return err
+				}
+				entry.Link = link
+			case entry.Mode.IsRegular():
+				contents, err := file.Open()
+				if err != nil {
+					return err
+				}
+				defer contents.Close()
+				entry.contents = contextReader{ctx, contents}
+			}
+			return fn(entry)
+		}()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readRarEntries calls fn with each entry of a RAR archive (without link targets)
+func readRarEntries(ctx context.Context, source string, password string, fn func(entry archiveEntry) error) error {
+	archive, err := openRar(source, password)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		header, err := archive.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entry := archiveEntry{Entry: Entry{
+			Name:     header.Name,
+			Size:     header.UnPackedSize,
+			Mode:     header.Mode(),
+			Modified: header.ModificationTime,
+		}}
+		if header.UnKnownSize {
+			entry.Size = -1
+		}
+		if entry.Mode.IsRegular() {
+			entry.contents = contextReader{ctx, archive}
+		}
+		err = fn(entry)
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"compress/flate"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/knowntraveler/gogo/fs"
+)
+
+// ArchiveOptions configure Archive
+type ArchiveOptions struct {
+	// Include limits the files added to the archive to those matching one of the
+	// glob patterns (e.g. "*.go" or "cmd/**"), directories are always added
+	// unless excluded
+	Include []string
+
+	// Exclude skips files and directories matching .gitignore-style rules
+	// (e.g. "node_modules/", ".git/" or "*.o")
+	Exclude []string
+
+	// IgnoreFile is the path of a .gitignore-style file (e.g. the .gitignore of
+	// the source directory) whose rules skip files and directories below the source
+	IgnoreFile string
+
+	// Ignore skips files and directories below the source matched by an
+	// fs.IgnoreMatcher (e.g. one loaded from a .gitignore file with fs.LoadIgnoreFile)
+	Ignore *fs.IgnoreMatcher
+
+	// Level is the CompressionLevel of the files of a zip archive and of tar archives
+	// compressed with a LevelCompressor such as gzip or zstd (default DefaultCompression)
+	Level CompressionLevel
+
+	// StoreExtensions are the file extensions (e.g. ".png") of already compressed files
+	// added to a zip archive without compression (nil uses DefaultStoreExtensions, an
+	// empty slice compresses every file)
+	StoreExtensions []string
+
+	// Password encrypts the files of a zip archive with AES-256 (the WinZip AES format
+	// supported by 7-Zip, WinZip and libarchive), tar archives can't be encrypted
+	Password string
+
+	// Reproducible writes byte-identical archives from identical trees (e.g. for build
+	// caches or attestation): every entry gets the ModTime, modes are normalized to
+	// 0755 for directories and executables and 0644 for other files, and tar entries
+	// are owned by root. Entries are always added in lexical order. Archives encrypted
+	// with a Password are not reproducible (each encryption uses a random salt).
+	Reproducible bool
+
+	// ModTime is the modification time of every entry of a Reproducible archive
+	// (default the SOURCE_DATE_EPOCH environment variable, or 1980-01-01 00:00 UTC)
+	ModTime time.Time
+
+	// Deduplicate stores files with the same contents once (e.g. vendored trees with
+	// many copies): a hard link or a file identical to an earlier file (compared by
+	// SHA256 checksum) is stored as a hard link entry to the earlier file in tar
+	// archives and as a relative symbolic link to it in zip archives
+	Deduplicate bool
+
+	// SplitSize splits the archive into parts of at most SplitSize bytes named
+	// target.001, target.002, ... (e.g. for artifact stores or mail systems with a
+	// size limit), which Unarchive reads when given the first part (default none)
+	SplitSize int64
+
+	// DryRun fills in the Plan with the entries that would be written instead of
+	// writing the archive (default none)
+	DryRun *Plan
+
+	// OnEntry is called before each entry is written with the entry name, its index
+	// and the total number of entries (default none)
+	OnEntry func(name string, index int, total int)
+
+	// Progress is called with the bytes of file contents written so far and the
+	// total size of the files as the archive is written (default none)
+	Progress func(processed int64, total int64)
+}
+
+// UnarchiveOptions configure Unarchive
+type UnarchiveOptions struct {
+	// Unsafe disables the checks rejecting entries that would be extracted outside
+	// the target directory (absolute names, ../ traversal or symbolic links inside
+	// the target) and the default extraction limits. Only set Unsafe for archives
+	// from a trusted source.
+	Unsafe bool
+
+	// MaxSize limits the total bytes extracted, failing with ErrLimitExceeded instead
+	// of filling the disk (0 uses DefaultMaxSize unless Unsafe is set, a negative
+	// value disables the limit)
+	MaxSize int64
+
+	// MaxEntries limits the number of entries in the archive (0 uses
+	// DefaultMaxEntries unless Unsafe is set, a negative value disables the limit)
+	MaxEntries int
+
+	// MaxEntrySize limits the bytes extracted from a single entry (0 uses
+	// DefaultMaxEntrySize unless Unsafe is set, a negative value disables the limit)
+	MaxEntrySize int64
+
+	// Password decrypts the encrypted entries of a zip archive (AES or legacy
+	// ZipCrypto) and encrypted 7z and RAR archives
+	Password string
+
+	// Links decides how symbolic links pointing outside the target directory are
+	// extracted (default LinkReject), links inside the target are always recreated
+	Links LinkPolicy
+
+	// Overwrite decides how files and symbolic links that already exist in the target
+	// directory are handled (default OverwriteAlways), existing directories are merged
+	Overwrite OverwritePolicy
+
+	// OnConflict is called for each entry whose target path already exists and
+	// returns the OverwritePolicy for that entry, replacing Overwrite (default none)
+	OnConflict func(conflict Conflict) OverwritePolicy
+
+	// DryRun fills in the Plan with the entries that would be extracted, overwritten
+	// or skipped instead of extracting the archive (default none). The checks of a
+	// real extraction apply, returning the same errors, and OnConflict is called.
+	DryRun *Plan
+
+	// OnEntry is called before each entry is extracted with the entry name, its index
+	// and the total number of entries (-1 for tar archives, which have no index)
+	OnEntry func(name string, index int, total int)
+
+	// Progress is called with the bytes processed so far and the total as the archive
+	// is extracted, counting the uncompressed contents of zip, 7z and RAR archives and
+	// the bytes of tar archives as read (the total is -1 when unknown)
+	Progress func(processed int64, total int64)
+}
+
+// VerifyOptions configure Verify
+type VerifyOptions struct {
+	// Password decrypts the encrypted entries of a zip archive so their contents
+	// can be checked (AES or legacy ZipCrypto)
+	Password string
+
+	// Manifest is the path of a checksum manifest (written by WriteChecksums or in the
+	// format of sha256sum) the file entries are checked against, every file in the
+	// archive must be listed with a matching checksum and every listed file must exist
+	// (default none)
+	Manifest string
+}
+
+// ChecksumOptions configure WriteChecksums
+type ChecksumOptions struct {
+	// Target is the path the manifest is written to (default the source path with a
+	// suffix named after the algorithm, e.g. release.zip.SHA256SUMS)
+	Target string
+
+	// Password decrypts the encrypted entries of a zip archive and encrypted 7z and
+	// RAR archives
+	Password string
+}
+
+// OverwritePolicy decides how an archive entry whose target path already exists is extracted
+type OverwritePolicy int
+
+// Overwrite Policies
+const (
+	OverwriteAlways  OverwritePolicy = iota // replace the existing file
+	OverwriteFail                           // fail the extraction
+	OverwriteSkip                           // keep the existing file
+	OverwriteIfNewer                        // replace the existing file if the entry is newer
+)
+
+// Conflict is an archive entry whose target path already exists, passed to
+// UnarchiveOptions.OnConflict
+type Conflict struct {
+	// Name is the name of the archive entry
+	Name string
+
+	// Path is the target path of the entry
+	Path string
+
+	// Modified is the modification time of the entry
+	Modified time.Time
+
+	// Existing is the FileInfo of the existing file (from os.Lstat)
+	Existing os.FileInfo
+}
+
+// Plan is the preview of an Archive or Unarchive call made with DryRun
+type Plan struct {
+	// Target is the archive written by Archive or the directory Unarchive extracts to
+	Target string
+
+	// Entries are the archive entries in the order they would be written or extracted
+	Entries []PlanEntry
+}
+
+// PlanEntry is an archive entry in a Plan
+type PlanEntry struct {
+	// Name is the slash-separated name of the entry (directories end in "/" for Archive)
+	Name string
+
+	// Path is the source path (Archive) or the target path (Unarchive) of the entry
+	Path string
+
+	// Size is the size of the entry contents
+	Size int64
+
+	// Mode is the file mode and permissions of the entry
+	Mode os.FileMode
+
+	// Action is what would be done with the entry
+	Action PlanAction
+}
+
+// PlanAction is what an Archive or Unarchive call would do with an entry
+type PlanAction int
+
+// Plan Actions
+const (
+	PlanCreate    PlanAction = iota // write the entry to a new path
+	PlanOverwrite                   // replace an existing file or symbolic link
+	PlanSkip                        // skip the entry (existing file kept, link outside the target or special file)
+	PlanMerge                       // merge a directory into an existing directory
+)
+
+// LinkPolicy decides how symbolic link entries pointing outside the target directory
+// (absolute link targets or relative targets using ../) are extracted
+type LinkPolicy int
+
+// Link Policies
+const (
+	LinkReject  LinkPolicy = iota // fail the extraction
+	LinkSkip                      // skip the symbolic link
+	LinkRewrite                   // root absolute link targets at the target directory, reject others
+)
+
+// CompressionLevel trades compression speed for size
+type CompressionLevel int
+
+// Compression Levels
+const (
+	DefaultCompression CompressionLevel = iota // balance of speed and size
+	Store                                      // no compression
+	Fastest                                    // fastest compression
+	BestCompression                            // smallest output
+)
+
+// flate returns the compress/flate (and compress/gzip) level for a CompressionLevel
+func (level CompressionLevel) flate() int {
+	switch level {
+	case Store:
+		return flate.NoCompression
+	case Fastest:
+		return flate.BestSpeed
+	case BestCompression:
+		return flate.BestCompression
+	}
+	return flate.DefaultCompression
+}
+
+// DefaultStoreExtensions are the extensions of already compressed file formats that
+// Archive adds to zip archives without compression
+var DefaultStoreExtensions = []string{
+	".7z", ".avif", ".br", ".bz2", ".docx", ".gif", ".gz", ".heic", ".jar", ".jpeg", ".jpg",
+	".mkv", ".mov", ".mp3", ".mp4", ".png", ".pptx", ".rar", ".tgz", ".webm", ".webp",
+	".xlsx", ".xz", ".zip", ".zst",
+}
+
+// GzipOptions configure GzipFile
+type GzipOptions struct {
+	// Level is the CompressionLevel (default DefaultCompression)
+	Level CompressionLevel
+}
+
+// DownloadOptions configure Download and DownloadResumable
+type DownloadOptions struct {
+	// Client sends the HTTP requests (default http.DefaultClient, which uses the
+	// proxy from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables)
+	Client *http.Client
+
+	// Headers are added to each request (e.g. Authorization or User-Agent)
+	Headers map[string]string
+
+	// Timeout limits each request, including reading the response body (default none)
+	Timeout time.Duration
+
+	// Retries is the number of times a failed request is retried by
+	// DownloadResumable (0 uses the default of 3 retries, a negative value
+	// disables retries)
+	Retries int
+
+	// Backoff is the delay before the first retry, doubling for each further
+	// retry (default 1 second)
+	Backoff time.Duration
+
+	// Progress is called with the bytes received so far and the total size (-1
+	// when unknown) as the download proceeds (default none)
+	Progress func(received int64, total int64)
+}
+
+// DownloadUnarchiveOptions configure DownloadAndUnarchive
+type DownloadUnarchiveOptions struct {
+	// Download configures the HTTP request (Client, Headers, Timeout and Progress)
+	Download DownloadOptions
+
+	// Unarchive configures the extraction
+	Unarchive UnarchiveOptions
+
+	// Format is the archive extension (e.g. ".zip" or ".tar.gz") for URLs whose
+	// path doesn't end with one (default detected from the URL path)
+	Format string
+
+	// Checksum is the expected SHA-256 checksum of the archive (hex encoded, or
+	// prefixed with another algorithm, e.g. "sha512:..."), verified before any
+	// entry is extracted (default none)
+	Checksum string
+}
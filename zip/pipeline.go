@@ -0,0 +1,136 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/knowntraveler/gogo/fs"
+)
+
+// DownloadAndUnarchive Function for Downloading an Archive File from a HTTP Source and
+// extracting it to a target directory in one call. Tar archives are extracted while the
+// response body streams in, without storing the archive. Zip archives (which are read
+// from their central directory at the end) and archives with a Checksum (which is
+// verified before any entry is extracted) are spooled to a temporary file that is
+// removed afterwards. Entries are extracted like Unarchive, rejecting entries that
+// would escape the target directory.
+func DownloadAndUnarchive(ctx context.Context, source string, target string, options ...DownloadUnarchiveOptions) error {
+
+	// Parse source url and validate 'source' is a valid HTTP URL
+	sourceURL, err := url.ParseRequestURI(source)
+	if err != nil {
+		return err
+	}
+
+	// Apply Download and Unarchive Options
+	pipelineOptions := DownloadUnarchiveOptions{}
+	if len(options) > 0 {
+		pipelineOptions = options[0]
+	}
+
+	// Detect Archive Format
+	format := pipelineOptions.Format
+	if format == "" {
+		format = sourceURL.Path
+	}
+	extension, compressor, isTar := archiveFormat(format)
+	if extension == "" {
+		return fmt.Errorf("Unable to detect the archive format of '%v', set DownloadUnarchiveOptions.Format", source)
+	}
+
+	// Stream Tar Archives
+	if isTar && pipelineOptions.Checksum == "" {
+		return streamTar(ctx, source, target, compressor, pipelineOptions)
+	}
+
+	// Spool Archive to a Temporary File
+	spool, err := os.CreateTemp("", "gogo-download-*"+extension)
+	if err != nil {
+		return err
+	}
+	spool.Close()
+	defer os.Remove(spool.Name())
+
+	err = DownloadContext(ctx, source, spool.Name(), pipelineOptions.Download)
+	if err != nil {
+		return err
+	}
+
+	// Verify Checksum
+	if pipelineOptions.Checksum != "" {
+		expected := pipelineOptions.Checksum
+		if !strings.Contains(expected, ":") {
+			expected = string(fs.SHA256) + ":" + expected
+		}
+		err = fs.VerifyChecksum(spool.Name(), expected)
+		if err != nil {
+			return fmt.Errorf("Download '%v' failed verification: %w", source, err)
+		}
+	}
+
+	return UnarchiveContext(ctx, spool.Name(), target, pipelineOptions.Unarchive)
+}
+
+// streamTar extracts a tar archive from the body of a HTTP response
+func streamTar(ctx context.Context, source string, target string, compressor Compressor, options DownloadUnarchiveOptions) error {
+	downloadOptions := options.Download
+	if downloadOptions.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, downloadOptions.Timeout)
+		defer cancel()
+	}
+
+	// Get the source data
+	request, err := newRequest(ctx, source, downloadOptions)
+	if err != nil {
+		return err
+	}
+	resp, err := downloadOptions.client().Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Check Response Status
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("Download '%v' failed: %v", source, resp.Status)
+	}
+
+	// Extract the body while it is received
+	body := withProgress(contextReader{ctx, resp.Body}, 0, resp.ContentLength, downloadOptions.Progress)
+	return extractTar(ctx, body, resp.ContentLength, source, target, compressor, options.Unarchive)
+}
+
+// archiveFormat returns the extension of a zip or tar archive name (e.g. ".tar.gz"),
+// and for tar archives its Compressor (nil for an uncompressed .tar), or "" for
+// names without a known archive extension
+func archiveFormat(name string) (string, Compressor, bool) {
+	lower := strings.ToLower(name)
+	if strings.HasSuffix(lower, ".zip") {
+		return ".zip", nil, false
+	}
+	compressor, ok := tarFormat(lower)
+	if !ok {
+		return "", nil, false
+	}
+	if compressor == nil {
+		return ".tar", nil, true
+	}
+	for _, extension := range compressor.Extensions() {
+		if strings.HasSuffix(lower, strings.ToLower(extension)) {
+			return extension, compressor, true
+		}
+	}
+	return "", nil, false
+}
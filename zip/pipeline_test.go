@@ -0,0 +1,127 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// archiveServer starts a HTTP server serving the files of a directory
+func archiveServer(t *testing.T, dir string) *httptest.Server {
+	server := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestArchiveFormat is a unit test for zip.archiveFormat()
+func TestArchiveFormat(t *testing.T) {
+	for name, expected := range map[string]string{
+		"/release.ZIP":     ".zip",
+		"/release.tar":     ".tar",
+		"/release.tar.gz":  ".tar.gz",
+		"/release.tgz":     ".tgz",
+		"/release.tar.zst": ".tar.zst",
+		"/download":        "",
+		"/release.7z":      "",
+	} {
+		extension, compressor, isTar := archiveFormat(name)
+
+		// Assert Unit Test
+		assert.Equal(t, expected, extension, name)
+		assert.Equal(t, expected != "" && expected != ".zip", isTar, name)
+		assert.Equal(t, expected != "" && expected != ".zip" && expected != ".tar", compressor != nil, name)
+	}
+}
+
+// TestDownloadAndUnarchive is a unit test for zip.DownloadAndUnarchive()
+func TestDownloadAndUnarchive(t *testing.T) {
+	dir := t.TempDir()
+	serve := filepath.Join(dir, "serve")
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"d/f.txt": "hello pipeline"})
+	assert.NoError(t, os.MkdirAll(serve, 0755))
+	for _, name := range []string{"a.zip", "a.tar.gz", "a.tar"} {
+		assert.NoError(t, Archive(source, filepath.Join(serve, name)))
+	}
+	server := archiveServer(t, serve)
+	if runtime.GOOS != "windows" {
+		t.Setenv("TMPDIR", t.TempDir())
+	}
+
+	for _, name := range []string{"a.zip", "a.tar.gz", "a.tar"} {
+		target := filepath.Join(dir, "out-"+name)
+		var received, total int64
+		options := DownloadUnarchiveOptions{Download: DownloadOptions{Progress: func(r int64, size int64) { received, total = r, size }}}
+
+		// Assert Unit Test
+		assert.NoError(t, DownloadAndUnarchive(context.Background(), server.URL+"/"+name, target, options), name)
+		data, err := os.ReadFile(filepath.Join(target, "d", "f.txt"))
+		assert.NoError(t, err, name)
+		assert.Equal(t, "hello pipeline", string(data), name)
+		info, err := os.Stat(filepath.Join(serve, name))
+		assert.NoError(t, err)
+		assert.Equal(t, info.Size(), received, name)
+		assert.Equal(t, info.Size(), total, name)
+	}
+
+	// Assert a Verified Archive is Extracted and its Spooled Download Removed
+	data, err := os.ReadFile(filepath.Join(serve, "a.tar.gz"))
+	assert.NoError(t, err)
+	sum := sha256.Sum256(data)
+	target := filepath.Join(dir, "verified")
+	assert.NoError(t, DownloadAndUnarchive(context.Background(), server.URL+"/a.tar.gz", target, DownloadUnarchiveOptions{Checksum: hex.EncodeToString(sum[:])}))
+	assert.FileExists(t, filepath.Join(target, "d", "f.txt"))
+	if runtime.GOOS != "windows" {
+		spooled, err := filepath.Glob(filepath.Join(os.TempDir(), "gogo-download-*"))
+		assert.NoError(t, err)
+		assert.Empty(t, spooled)
+	}
+
+	// Assert a URL without an Extension uses the Format
+	assert.NoError(t, os.Rename(filepath.Join(serve, "a.tar"), filepath.Join(serve, "download")))
+	target = filepath.Join(dir, "format")
+	assert.NoError(t, DownloadAndUnarchive(context.Background(), server.URL+"/download", target, DownloadUnarchiveOptions{Format: ".tar"}))
+	assert.FileExists(t, filepath.Join(target, "d", "f.txt"))
+}
+
+// TestDownloadAndUnarchiveErrors is a unit test for zip.DownloadAndUnarchive() failing
+func TestDownloadAndUnarchiveErrors(t *testing.T) {
+	dir := t.TempDir()
+	serve := filepath.Join(dir, "serve")
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"d/f.txt": "hello pipeline"})
+	assert.NoError(t, os.MkdirAll(serve, 0755))
+	assert.NoError(t, Archive(source, filepath.Join(serve, "a.tar.gz")))
+	writeTestTar(t, filepath.Join(serve, "evil.tar"), testEntry{name: "l", link: "/etc"}, testEntry{name: "l/passwd", body: "root"})
+	server := archiveServer(t, serve)
+	ctx := context.Background()
+
+	// Assert a Checksum Mismatch extracts Nothing
+	target := filepath.Join(dir, "mismatch")
+	err := DownloadAndUnarchive(ctx, server.URL+"/a.tar.gz", target, DownloadUnarchiveOptions{Checksum: "sha256:" + hex.EncodeToString(make([]byte, 32))})
+	assert.ErrorContains(t, err, "failed verification")
+	assert.NoDirExists(t, target)
+
+	// Assert Unit Test
+	assert.ErrorContains(t, DownloadAndUnarchive(ctx, server.URL+"/download", filepath.Join(dir, "x")), "set DownloadUnarchiveOptions.Format")
+	assert.ErrorContains(t, DownloadAndUnarchive(ctx, server.URL+"/missing.zip", filepath.Join(dir, "y")), "404")
+	assert.ErrorContains(t, DownloadAndUnarchive(ctx, server.URL+"/missing.tar", filepath.Join(dir, "y")), "404")
+	assert.ErrorContains(t, DownloadAndUnarchive(ctx, server.URL+"/evil.tar", filepath.Join(dir, "z")), "points outside")
+	assert.Error(t, DownloadAndUnarchive(ctx, "not a url", filepath.Join(dir, "z")))
+}
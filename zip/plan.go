@@ -0,0 +1,143 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"fmt"
+	"os"
+)
+
+// planArchive fills in ArchiveOptions.DryRun with the entries Archive would write below sources
+func planArchive(sources []Source, target string, options ArchiveOptions) error {
+
+	// Verify Sources Exist
+	for _, source := range sources {
+		_, err := source.stat()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create Archive Filter
+	filter, err := newArchiveFilter(options)
+	if err != nil {
+		return err
+	}
+
+	// Add each Entry
+	plan := options.DryRun
+	*plan = Plan{Target: target}
+	return walkSources(sources, filter, func(source Source, name string, path string, info os.FileInfo) error {
+		entry := PlanEntry{Name: name, Path: path, Mode: info.Mode(), Action: PlanCreate}
+		if info.Mode().IsRegular() {
+			entry.Size = info.Size()
+		}
+		plan.Entries = append(plan.Entries, entry)
+		return nil
+	})
+}
+
+// planUnarchive fills in UnarchiveOptions.DryRun with the entries Unarchive would extract
+func planUnarchive(source string, target string, options UnarchiveOptions) error {
+
+	// List Source Archive
+	entries, err := List(source, options)
+	if err != nil {
+		return err
+	}
+
+	if target == "" {
+		target = "./"
+	}
+
+	// Check Extraction Limits
+	limits := newExtractLimits(options)
+	size := int64(0)
+	for _, entry := range entries {
+		size += entry.Size
+	}
+	err = limits.check(len(entries), size)
+	if err != nil {
+		return err
+	}
+
+	// Add each Entry
+	plan := options.DryRun
+	*plan = Plan{Target: target}
+	for _, entry := range entries {
+		err = limits.entry(entry.Name, entry.Size)
+		if err != nil {
+			return err
+		}
+
+		// Set Extracted Filepath (rejecting entries that escape the target directory)
+		extractedFilePath, err := extractPath(target, entry.Name, options)
+		if err != nil {
+			return fmt.Errorf("Archive entry '%v' is unsafe: %v", entry.Name, err)
+		}
+
+		action, err := planEntry(source, target, extractedFilePath, entry, options)
+		if err != nil {
+			return err
+		}
+		plan.Entries = append(plan.Entries, PlanEntry{
+			Name:   entry.Name,
+			Path:   extractedFilePath,
+			Size:   entry.Size,
+			Mode:   entry.Mode,
+			Action: action,
+		})
+	}
+
+	return nil
+}
+
+// planEntry returns the PlanAction for an archive entry extracted to path
+func planEntry(source string, target string, path string, entry Entry, options UnarchiveOptions) (PlanAction, error) {
+	mode := entry.Mode
+
+	// Merge Existing Directories
+	if mode.IsDir() {
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			return PlanMerge, nil
+		}
+		return PlanCreate, nil
+	}
+
+	// Skip Special Files
+	if !mode.IsRegular() && mode&os.ModeSymlink == 0 {
+		return PlanSkip, nil
+	}
+
+	// Check Existing Files
+	_, err := os.Lstat(path)
+	exists := err == nil
+	extract, err := checkConflict(entry.Name, path, entry.Modified, options)
+	if err != nil || !extract {
+		return PlanSkip, err
+	}
+
+	// Check Symbolic Links (RAR links are not extracted)
+	if mode&os.ModeSymlink != 0 {
+		if isRar(source) {
+			return PlanSkip, nil
+		}
+		if !options.Unsafe {
+			link, err := safeLink(target, path, entry.Link, options.Links)
+			if err != nil || link == "" {
+				return PlanSkip, err
+			}
+		}
+	}
+
+	if exists {
+		return PlanOverwrite, nil
+	}
+	return PlanCreate, nil
+}
@@ -0,0 +1,98 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// planActions returns the PlanAction of each entry of a Plan by name
+func planActions(plan *Plan) map[string]PlanAction {
+	actions := map[string]PlanAction{}
+	for _, entry := range plan.Entries {
+		actions[entry.Name] = entry.Action
+	}
+	return actions
+}
+
+// TestArchiveDryRun is a unit test for zip.Archive() with ArchiveOptions.DryRun
+func TestArchiveDryRun(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "hello", "sub/b.txt": "bbb", "skip.log": "x"})
+
+	for _, name := range []string{"plan.zip", "plan.tar.gz"} {
+		target := filepath.Join(dir, name)
+		plan := &Plan{}
+
+		// Assert Unit Test
+		assert.NoError(t, Archive(source, target, ArchiveOptions{DryRun: plan, Exclude: []string{"*.log"}}))
+		assert.NoFileExists(t, target)
+		assert.Equal(t, target, plan.Target)
+		sizes := map[string]int64{}
+		for _, entry := range plan.Entries {
+			sizes[entry.Name] = entry.Size
+			assert.Equal(t, PlanCreate, entry.Action, entry.Name)
+		}
+		assert.Equal(t, map[string]int64{"a.txt": 5, "sub/": 0, "sub/b.txt": 3}, sizes, name)
+	}
+
+	// Assert a Dry Run fails like a Real Run
+	target := filepath.Join(dir, "missing.zip")
+	assert.Error(t, Archive(filepath.Join(dir, "missing"), target, ArchiveOptions{DryRun: &Plan{}}))
+	assert.NoFileExists(t, target)
+}
+
+// TestUnarchiveDryRun is a unit test for zip.Unarchive() with UnarchiveOptions.DryRun
+func TestUnarchiveDryRun(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "hello", "sub/b.txt": "bbb"})
+
+	for _, name := range []string{"plan.zip", "plan.tar.gz"} {
+		archive := filepath.Join(dir, name)
+		assert.NoError(t, Archive(source, archive))
+		target := filepath.Join(dir, "out-"+name)
+		writeTestTree(t, target, map[string]string{"a.txt": "old", "sub/c.txt": "keep"})
+		plan := &Plan{}
+
+		// Assert Unit Test
+		assert.NoError(t, Unarchive(archive, target, UnarchiveOptions{DryRun: plan}))
+		assert.Equal(t, map[string]PlanAction{"a.txt": PlanOverwrite, "sub/": PlanMerge, "sub/b.txt": PlanCreate}, planActions(plan), name)
+		data, err := os.ReadFile(filepath.Join(target, "a.txt"))
+		assert.NoError(t, err)
+		assert.Equal(t, "old", string(data))
+		assert.NoFileExists(t, filepath.Join(target, "sub", "b.txt"))
+
+		// Assert the Overwrite Policy is Planned
+		plan = &Plan{}
+		assert.NoError(t, Unarchive(archive, target, UnarchiveOptions{DryRun: plan, Overwrite: OverwriteSkip}))
+		assert.Equal(t, PlanSkip, planActions(plan)["a.txt"], name)
+		assert.ErrorContains(t, Unarchive(archive, target, UnarchiveOptions{DryRun: &Plan{}, Overwrite: OverwriteFail}), "already exists", name)
+	}
+}
+
+// TestUnarchiveDryRunLinks is a unit test for zip.Unarchive() with UnarchiveOptions.DryRun rejecting links like a real run
+func TestUnarchiveDryRunLinks(t *testing.T) {
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "links.tar")
+	writeTestTar(t, archive, testEntry{name: "a.txt", body: "hello"}, testEntry{name: "link", link: "a.txt"}, testEntry{name: "evil", link: "/etc/passwd"})
+
+	// Assert Unit Test
+	target := filepath.Join(dir, "out")
+	assert.ErrorContains(t, Unarchive(archive, target, UnarchiveOptions{DryRun: &Plan{}}), "points outside")
+	plan := &Plan{}
+	assert.NoError(t, Unarchive(archive, target, UnarchiveOptions{DryRun: plan, Links: LinkSkip}))
+	assert.Equal(t, map[string]PlanAction{"a.txt": PlanCreate, "link": PlanCreate, "evil": PlanSkip}, planActions(plan))
+	assert.NoDirExists(t, target)
+}
@@ -0,0 +1,110 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"io"
+	"os"
+)
+
+// progressReader is an io.Reader calling a progress callback with the bytes read
+// so far and the total size after each read
+type progressReader struct {
+	reader   io.Reader
+	read     int64
+	total    int64
+	progress func(read int64, total int64)
+}
+
+// withProgress returns a reader reporting progress from an offset, or the reader
+// itself when progress is nil
+func withProgress(reader io.Reader, offset int64, total int64, progress func(read int64, total int64)) io.Reader {
+	if progress == nil {
+		return reader
+	}
+	return &progressReader{reader: reader, read: offset, total: total, progress: progress}
+}
+
+// Read reads from the underlying reader and reports the progress
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.read += int64(n)
+		r.progress(r.read, r.total)
+	}
+	return n, err
+}
+
+// archiveProgress reports the entries and bytes processed by Archive or Unarchive to
+// the OnEntry and Progress callbacks of their options, a nil archiveProgress reports nothing
+type archiveProgress struct {
+	onEntry   func(name string, index int, total int)
+	progress  func(processed int64, total int64)
+	entries   int
+	index     int
+	size      int64
+	processed int64
+}
+
+// newArchiveProgress counts the entries and file bytes below sources (except duplicate
+// files, which are stored as links) for the callbacks of ArchiveOptions, returning nil
+// when no callbacks are set
+func newArchiveProgress(sources []Source, filter *archiveFilter, duplicates map[string]string, options ArchiveOptions) (*archiveProgress, error) {
+	if options.OnEntry == nil && options.Progress == nil {
+		return nil, nil
+	}
+
+	// Count Entries and File Bytes
+	progress := &archiveProgress{onEntry: options.OnEntry, progress: options.Progress}
+	err := walkSources(sources, filter, func(source Source, name string, path string, info os.FileInfo) error {
+		progress.entries++
+		if _, duplicate := duplicates[name]; info.Mode().IsRegular() && !duplicate {
+			progress.size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return progress, nil
+}
+
+// entry reports the next entry
+func (p *archiveProgress) entry(name string) {
+	if p == nil || p.onEntry == nil {
+		return
+	}
+	p.onEntry(name, p.index, p.entries)
+	p.index++
+}
+
+// reader returns a reader adding the bytes read to the processed bytes
+func (p *archiveProgress) reader(reader io.Reader) io.Reader {
+	if p == nil || p.progress == nil {
+		return reader
+	}
+	return &progressCounter{reader: reader, archive: p}
+}
+
+// progressCounter is an io.Reader adding the bytes read to an archiveProgress
+type progressCounter struct {
+	reader  io.Reader
+	archive *archiveProgress
+}
+
+// Read reads from the underlying reader and reports the progress
+func (r *progressCounter) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.archive.processed += int64(n)
+		r.archive.progress(r.archive.processed, r.archive.size)
+	}
+	return n, err
+}
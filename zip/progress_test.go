@@ -0,0 +1,102 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// progressRecorder records the calls of the OnEntry and Progress callbacks
+type progressRecorder struct {
+	names     []string
+	indexes   []int
+	totals    []int
+	processed int64
+	size      int64
+}
+
+// onEntry records an OnEntry call
+func (r *progressRecorder) onEntry(name string, index int, total int) {
+	r.names = append(r.names, name)
+	r.indexes = append(r.indexes, index)
+	r.totals = append(r.totals, total)
+}
+
+// progress records a Progress call
+func (r *progressRecorder) progress(processed int64, size int64) {
+	r.processed, r.size = processed, size
+}
+
+// TestWithProgress is a unit test for zip.withProgress()
+func TestWithProgress(t *testing.T) {
+	reader := bytes.NewReader(make([]byte, 100))
+	assert.Equal(t, io.Reader(reader), withProgress(reader, 0, 100, nil))
+
+	// Assert Unit Test
+	var reads, totals []int64
+	progress := withProgress(reader, 50, 150, func(read int64, total int64) {
+		reads = append(reads, read)
+		totals = append(totals, total)
+	})
+	_, err := io.CopyBuffer(struct{ io.Writer }{io.Discard}, progress, make([]byte, 40))
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{90, 130, 150}, reads)
+	assert.Equal(t, []int64{150, 150, 150}, totals)
+}
+
+// TestArchiveProgress is a unit test for zip.Archive() and zip.Unarchive() with the OnEntry and Progress callbacks
+func TestArchiveProgress(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"d/f.txt": string(make([]byte, 70000)), "g.txt": "abc", "skip.o": "abc"})
+
+	for _, name := range []string{"progress.zip", "progress.tar.gz"} {
+		archive := filepath.Join(dir, name)
+		recorder := &progressRecorder{}
+		options := ArchiveOptions{Exclude: []string{"*.o"}, OnEntry: recorder.onEntry, Progress: recorder.progress}
+
+		// Assert Unit Test
+		assert.NoError(t, Archive(source, archive, options))
+		assert.Equal(t, []string{"d/", "d/f.txt", "g.txt"}, recorder.names, name)
+		assert.Equal(t, []int{0, 1, 2}, recorder.indexes, name)
+		assert.Equal(t, []int{3, 3, 3}, recorder.totals, name)
+		assert.Equal(t, int64(70003), recorder.processed, name)
+		assert.Equal(t, int64(70003), recorder.size, name)
+
+		// Assert Extraction Progress
+		recorder = &progressRecorder{}
+		assert.NoError(t, Unarchive(archive, filepath.Join(dir, "out-"+name), UnarchiveOptions{OnEntry: recorder.onEntry, Progress: recorder.progress}))
+		assert.ElementsMatch(t, []string{"d/", "d/f.txt", "g.txt"}, recorder.names, name)
+		assert.Equal(t, recorder.size, recorder.processed, name)
+		assert.Greater(t, recorder.processed, int64(0), name)
+	}
+}
+
+// TestArchiveProgressErrors is a unit test for zip.Archive() reporting no progress for a failed archive
+func TestArchiveProgressErrors(t *testing.T) {
+	dir := t.TempDir()
+	recorder := &progressRecorder{}
+	options := ArchiveOptions{OnEntry: recorder.onEntry, Progress: recorder.progress}
+
+	// Assert Unit Test
+	target := filepath.Join(dir, "missing.zip")
+	assert.Error(t, Archive(filepath.Join(dir, "missing"), target, options))
+	assert.NoFileExists(t, target)
+	assert.Empty(t, recorder.names)
+	target = filepath.Join(dir, "unreadable.zip")
+	fsys := unreadableFS{fstest.MapFS{"bad/unreadable.txt": {Data: []byte("unreadable")}}}
+	assert.Error(t, ArchiveFS(fsys, target, options))
+	assert.NoFileExists(t, target)
+}
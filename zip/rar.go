@@ -0,0 +1,138 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nwaples/rardecode/v2"
+)
+
+// isRar reports whether a file name is a RAR archive (.rar, including the first
+// volume of a multi-volume archive such as .part1.rar)
+func isRar(name string) bool {
+	return strings.HasSuffix(strings.ToLower(name), ".rar")
+}
+
+// openRar opens a RAR archive (and its further volumes), decrypting it with the password if set
+func openRar(source string, password string) (*rardecode.ReadCloser, error) {
+	if password != "" {
+		return rardecode.OpenReader(source, rardecode.Password(password))
+	}
+	return rardecode.OpenReader(source)
+}
+
+// unarchiveRar Function for Extracting a RAR Archive File (.rar), RAR archives can
+// only be read. Directories and regular files are extracted, links are skipped.
+func unarchiveRar(ctx context.Context, source string, target string, options UnarchiveOptions) error {
+
+	// Open Source Archive
+	archive, err := openRar(source, options.Password)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	if target == "" {
+		target = "./"
+	}
+
+	// Iterate through each Entry found in Source Archive (RAR archives have no index,
+	// the progress counts the extracted bytes)
+	progress := &archiveProgress{onEntry: options.OnEntry, progress: options.Progress, entries: -1, size: -1}
+	limits := newExtractLimits(options)
+	var dirs []extractedDir
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		header, err := archive.Next()
+		if err == io.EOF {
+			return restoreDirs(dirs)
+		}
+		if err != nil {
+			return err
+		}
+		progress.entry(header.Name)
+
+		// Check Extraction Limits
+		size := header.UnPackedSize
+		if header.UnKnownSize {
+			size = -1
+		}
+		err = limits.entry(header.Name, size)
+		if err != nil {
+			return err
+		}
+
+		// Set Extracted Filepath (rejecting entries that escape the target directory)
+		extractedFilePath, err := extractPath(target, header.Name, options)
+		if err != nil {
+			return fmt.Errorf("Archive entry '%v' is unsafe: %v", header.Name, err)
+		}
+
+		// Check Existing Files (directories are merged)
+		mode := header.Mode()
+		if !mode.IsDir() {
+			extract, err := checkConflict(header.Name, extractedFilePath, header.ModificationTime, options)
+			if err != nil {
+				return err
+			}
+			if !extract {
+				continue
+			}
+		}
+
+		switch {
+		case mode.IsDir():
+			// Create Directory (permissions and modification time are restored
+			// once all entries are extracted)
+			err = os.MkdirAll(extractedFilePath, 0755)
+			dirs = append(dirs, extractedDir{extractedFilePath, fileMode(mode, true), header.ModificationTime})
+		case mode.IsRegular():
+			// Extract Regular File
+			err = extractFile(ctx, archive, extractedFilePath, fileMode(mode, false), header.ModificationTime, progress, limits)
+		default:
+			// Links and Special Files are not extracted
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// listRar returns the entries of a RAR archive
+func listRar(source string, password string) ([]Entry, error) {
+	archive, err := openRar(source, password)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	var entries []Entry
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, Entry{
+			Name:     header.Name,
+			Size:     header.UnPackedSize,
+			Mode:     header.Mode(),
+			Modified: header.ModificationTime,
+		})
+	}
+}
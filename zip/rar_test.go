@@ -0,0 +1,62 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIsRar is a unit test for zip.isRar()
+func TestIsRar(t *testing.T) {
+	assert.True(t, isRar("release.rar"))
+	assert.True(t, isRar("release.part1.RAR"))
+	assert.False(t, isRar("release.tar"))
+}
+
+// TestUnarchiveRar is a unit test for zip.Unarchive() and zip.List() with a RAR archive
+func TestUnarchiveRar(t *testing.T) {
+	archive := filepath.Join("testdata", "stored.rar")
+	target := filepath.Join(t.TempDir(), "out")
+
+	// Assert Unit Test
+	assert.NoError(t, Unarchive(archive, target))
+	data, err := os.ReadFile(filepath.Join(target, "readme.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello from rar", string(data))
+	info, err := os.Stat(filepath.Join(target, "dir", "tool.sh"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2021, info.ModTime().Year())
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+	}
+	assert.Equal(t, []string{"dir", "dir/tool.sh", "readme.txt"}, archiveNames(t, archive))
+}
+
+// TestUnarchiveRarErrors is a unit test for zip.Unarchive() rejecting unsafe and corrupt RAR archives
+func TestUnarchiveRarErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	// Assert Unit Test
+	target := filepath.Join(dir, "unsafe")
+	assert.ErrorContains(t, Unarchive(filepath.Join("testdata", "unsafe.rar"), target), "unsafe")
+	assert.NoFileExists(t, filepath.Join(dir, "evil.txt"))
+	data, err := os.ReadFile(filepath.Join("testdata", "stored.rar"))
+	assert.NoError(t, err)
+	truncated := filepath.Join(dir, "truncated.rar")
+	assert.NoError(t, os.WriteFile(truncated, data[:len(data)-40], 0644))
+	assert.Error(t, Unarchive(truncated, filepath.Join(dir, "truncated")))
+	err = Unarchive(filepath.Join("testdata", "stored.rar"), filepath.Join(dir, "limited"), UnarchiveOptions{MaxEntries: 2})
+	assert.True(t, errors.Is(err, ErrLimitExceeded), err)
+}
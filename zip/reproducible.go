@@ -0,0 +1,65 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"archive/tar"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// defaultEpoch is the modification time of the entries of a Reproducible archive when
+// neither ArchiveOptions.ModTime nor SOURCE_DATE_EPOCH is set (the earliest time an
+// MS-DOS timestamp in a zip archive can hold)
+var defaultEpoch = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// reproducibleTime returns the modification time of the entries of a Reproducible
+// archive: the ModTime option, the SOURCE_DATE_EPOCH environment variable (seconds
+// since the Unix epoch, see https://reproducible-builds.org/specs/source-date-epoch/)
+// or defaultEpoch
+func reproducibleTime(modified time.Time) (time.Time, error) {
+	if !modified.IsZero() {
+		return modified.UTC().Truncate(time.Second), nil
+	}
+	if epoch := os.Getenv("SOURCE_DATE_EPOCH"); epoch != "" {
+		seconds, err := strconv.ParseInt(epoch, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("SOURCE_DATE_EPOCH '%v' is not a Unix timestamp", epoch)
+		}
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+	return defaultEpoch, nil
+}
+
+// normalMode returns the mode recorded for an entry of a Reproducible archive, 0755 for
+// directories and executable files and 0644 for other files
+func normalMode(mode os.FileMode) os.FileMode {
+	switch {
+	case mode.IsDir():
+		return os.ModeDir | 0755
+	case mode&os.ModeSymlink != 0:
+		return os.ModeSymlink | 0777
+	case mode&0111 != 0:
+		return mode.Type() | 0755
+	}
+	return mode.Type() | 0644
+}
+
+// normalizeTarHeader pins the modification time and normalizes the mode and ownership
+// of a tar header for a Reproducible archive
+func normalizeTarHeader(header *tar.Header, modified time.Time) {
+	header.Mode = int64(normalMode(header.FileInfo().Mode()).Perm())
+	header.ModTime = modified
+	header.AccessTime = time.Time{}
+	header.ChangeTime = time.Time{}
+	header.Uid, header.Gid = 0, 0
+	header.Uname, header.Gname = "", ""
+}
@@ -0,0 +1,114 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// reproducibleTree writes a source tree with a modification time and permissions
+func reproducibleTree(t *testing.T, dir string, modified time.Time, perm os.FileMode) string {
+	writeTestTree(t, dir, map[string]string{"sub/b.txt": "bbb", "run.sh": "#!/bin/sh"})
+	assert.NoError(t, os.Chmod(filepath.Join(dir, "sub", "b.txt"), perm))
+	assert.NoError(t, os.Chmod(filepath.Join(dir, "run.sh"), 0700))
+	for _, name := range []string{"sub/b.txt", "run.sh", "sub"} {
+		assert.NoError(t, os.Chtimes(filepath.Join(dir, name), modified, modified))
+	}
+	return dir
+}
+
+// TestReproducibleTime is a unit test for zip.reproducibleTime()
+func TestReproducibleTime(t *testing.T) {
+	pinned := time.Date(2020, 5, 1, 12, 0, 0, 500, time.FixedZone("CEST", 7200))
+	modified, err := reproducibleTime(pinned)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC), modified)
+
+	// Assert SOURCE_DATE_EPOCH
+	t.Setenv("SOURCE_DATE_EPOCH", "")
+	modified, err = reproducibleTime(time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, defaultEpoch, modified)
+	t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+	modified, err = reproducibleTime(time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1700000000), modified.Unix())
+	t.Setenv("SOURCE_DATE_EPOCH", "yesterday")
+	_, err = reproducibleTime(time.Time{})
+	assert.EqualError(t, err, "SOURCE_DATE_EPOCH 'yesterday' is not a Unix timestamp")
+}
+
+// TestNormalMode is a unit test for zip.normalMode()
+func TestNormalMode(t *testing.T) {
+	assert.Equal(t, os.ModeDir|0755, normalMode(os.ModeDir|0700))
+	assert.Equal(t, os.ModeSymlink|0777, normalMode(os.ModeSymlink|0700))
+	assert.Equal(t, os.FileMode(0755), normalMode(0700))
+	assert.Equal(t, os.FileMode(0644), normalMode(0600))
+}
+
+// TestArchiveReproducible is a unit test for zip.Archive() with ArchiveOptions.Reproducible
+func TestArchiveReproducible(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permissions are not supported")
+	}
+	t.Setenv("SOURCE_DATE_EPOCH", "")
+	dir := t.TempDir()
+	a := reproducibleTree(t, filepath.Join(dir, "a"), time.Now(), 0600)
+	b := reproducibleTree(t, filepath.Join(dir, "b"), time.Now().Add(-time.Hour), 0640)
+
+	for _, ext := range []string{".zip", ".tar", ".tar.gz", ".tar.zst", ".tar.xz"} {
+		archiveA, archiveB := filepath.Join(dir, "a"+ext), filepath.Join(dir, "b"+ext)
+		assert.NoError(t, Archive(a, archiveA, ArchiveOptions{Reproducible: true}))
+		assert.NoError(t, Archive(b, archiveB, ArchiveOptions{Reproducible: true}))
+
+		// Assert Unit Test
+		dataA, err := os.ReadFile(archiveA)
+		assert.NoError(t, err)
+		dataB, err := os.ReadFile(archiveB)
+		assert.NoError(t, err)
+		assert.True(t, bytes.Equal(dataA, dataB), ext)
+		entries, err := List(archiveA)
+		assert.NoError(t, err)
+		for _, entry := range entries {
+			assert.True(t, defaultEpoch.Equal(entry.Modified), "%v %v: %v", ext, entry.Name, entry.Modified)
+			assert.Equal(t, normalMode(entry.Mode), entry.Mode, "%v %v", ext, entry.Name)
+		}
+	}
+
+	// Assert a Pinned ModTime
+	pinned := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	archive := filepath.Join(dir, "pinned.tar")
+	assert.NoError(t, Archive(a, archive, ArchiveOptions{Reproducible: true, ModTime: pinned}))
+	entries, err := List(archive)
+	assert.NoError(t, err)
+	assert.True(t, pinned.Equal(entries[0].Modified))
+}
+
+// TestArchiveReproducibleErrors is a unit test for zip.Archive() failing with an invalid SOURCE_DATE_EPOCH
+func TestArchiveReproducibleErrors(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "alpha"})
+	t.Setenv("SOURCE_DATE_EPOCH", "bad")
+
+	// Assert Unit Test
+	for _, name := range []string{"bad.zip", "bad.tar.gz"} {
+		target := filepath.Join(dir, name)
+		assert.ErrorContains(t, Archive(source, target, ArchiveOptions{Reproducible: true}), "SOURCE_DATE_EPOCH")
+		assert.NoFileExists(t, target)
+	}
+	assert.NoError(t, Archive(source, filepath.Join(dir, "ignored.zip")))
+}
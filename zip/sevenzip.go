@@ -0,0 +1,170 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bodgit/sevenzip"
+)
+
+// isSevenZip reports whether a file name is a 7z archive (.7z or .7z.001)
+func isSevenZip(name string) bool {
+	return strings.HasSuffix(strings.ToLower(trimPart(name)), ".7z")
+}
+
+// openSevenZip opens a 7z archive, decrypting it with the password if set
+func openSevenZip(source string, password string) (*sevenzip.ReadCloser, error) {
+	if password != "" {
+		return sevenzip.OpenReaderWithPassword(source, password)
+	}
+	return sevenzip.OpenReader(source)
+}
+
+// unarchiveSevenZip Function for Extracting a 7z Archive File (.7z), 7z archives can
+// only be read
+func unarchiveSevenZip(ctx context.Context, source string, target string, options UnarchiveOptions) error {
+
+	// Open Source Archive
+	archive, err := openSevenZip(source, options.Password)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	if target == "" {
+		target = "./"
+	}
+
+	// Count Entries and Bytes for Progress Callbacks
+	progress := &archiveProgress{onEntry: options.OnEntry, progress: options.Progress, entries: len(archive.File)}
+	for _, file := range archive.File {
+		progress.size += int64(file.UncompressedSize)
+	}
+
+	// Check Extraction Limits
+	limits := newExtractLimits(options)
+	err = limits.check(len(archive.File), progress.size)
+	if err != nil {
+		return err
+	}
+
+	// Iterate through each Entry found in Source Archive
+	var dirs []extractedDir
+	for _, file := range archive.File {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		progress.entry(file.Name)
+		err = limits.entry(file.Name, int64(file.UncompressedSize))
+		if err != nil {
+			return err
+		}
+
+		// Set Extracted Filepath (rejecting entries that escape the target directory)
+		extractedFilePath, err := extractPath(target, file.Name, options)
+		if err != nil {
+			return fmt.Errorf("Archive entry '%v' is unsafe: %v", file.Name, err)
+		}
+
+		// Check Existing Files (directories are merged)
+		mode := file.Mode()
+		if !mode.IsDir() {
+			extract, err := checkConflict(file.Name, extractedFilePath, file.Modified, options)
+			if err != nil {
+				return err
+			}
+			if !extract {
+				continue
+			}
+		}
+
+		switch {
+		case mode.IsDir():
+			// Create Directory (permissions and modification time are restored
+			// once all entries are extracted)
+			err = os.MkdirAll(extractedFilePath, 0755)
+			dirs = append(dirs, extractedDir{extractedFilePath, fileMode(mode, true), file.Modified})
+		case mode&os.ModeSymlink != 0:
+			// Recreate Symbolic Link (the link target is stored as the contents)
+			err = extractSevenZipLink(file, target, extractedFilePath, options)
+		case mode.IsRegular():
+			// Extract Regular File
+			err = extractSevenZipFile(ctx, file, extractedFilePath, progress, limits)
+		default:
+			// Special Files are not extracted
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return restoreDirs(dirs)
+}
+
+// extractSevenZipFile writes the contents of a 7z entry to a file
+func extractSevenZipFile(ctx context.Context, file *sevenzip.File, path string, progress *archiveProgress, limits *extractLimits) error {
+	contents, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer contents.Close()
+
+	return extractFile(ctx, contents, path, fileMode(file.Mode(), false), file.Modified, progress, limits)
+}
+
+// extractSevenZipLink creates a symbolic link from a 7z entry
+func extractSevenZipLink(file *sevenzip.File, target string, path string, options UnarchiveOptions) error {
+	link, err := readSevenZipLink(file)
+	if err != nil {
+		return err
+	}
+	return extractSymlink(target, path, link, options)
+}
+
+// readSevenZipLink reads the target of a symbolic link stored as the contents of a 7z entry
+func readSevenZipLink(file *sevenzip.File) (string, error) {
+	contents, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer contents.Close()
+
+	return readLink(contents)
+}
+
+// listSevenZip returns the entries of a 7z archive
+func listSevenZip(source string, password string) ([]Entry, error) {
+	archive, err := openSevenZip(source, password)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	entries := make([]Entry, 0, len(archive.File))
+	for _, file := range archive.File {
+		entry := Entry{
+			Name:     file.Name,
+			Size:     int64(file.UncompressedSize),
+			Mode:     file.Mode(),
+			Modified: file.Modified,
+		}
+		if entry.Mode&os.ModeSymlink != 0 {
+			entry.Link, err = readSevenZipLink(file)
+			if err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIsSevenZip is a unit test for zip.isSevenZip()
+func TestIsSevenZip(t *testing.T) {
+	assert.True(t, isSevenZip("release.7z"))
+	assert.True(t, isSevenZip("release.7Z.001"))
+	assert.False(t, isSevenZip("release.zip"))
+}
+
+// TestUnarchiveSevenZip is a unit test for zip.Unarchive() and zip.List() with 7z archives written by bsdtar
+func TestUnarchiveSevenZip(t *testing.T) {
+	supportsSymlinks(t)
+	target := filepath.Join(t.TempDir(), "out")
+
+	// Assert Unit Test
+	assert.NoError(t, Unarchive(filepath.Join("testdata", "bsdtar.7z"), target))
+	data, err := os.ReadFile(filepath.Join(target, "a.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hi\n", string(data))
+	link, err := os.Readlink(filepath.Join(target, "link"))
+	assert.NoError(t, err)
+	assert.Equal(t, "a.txt", link)
+	info, err := os.Stat(filepath.Join(target, "bin", "run"))
+	assert.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+	}
+	entries, err := List(filepath.Join("testdata", "bsdtar.7z"))
+	assert.NoError(t, err)
+	names := map[string]string{}
+	for _, entry := range entries {
+		names[strings.TrimPrefix(entry.Name, "./")] = entry.Link
+	}
+	assert.Equal(t, "a.txt", names["link"])
+	assert.Contains(t, names, "bin/run")
+
+	// Assert LZMA2 Compressed Entries
+	target = filepath.Join(t.TempDir(), "lzma2")
+	assert.NoError(t, Unarchive(filepath.Join("testdata", "bsdtar-lzma2.7z"), target))
+	data, err = os.ReadFile(filepath.Join(target, "lorem.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("lorem ipsum dolor sit amet ", 200)+"\n", string(data))
+}
+
+// TestUnarchiveSevenZipErrors is a unit test for zip.Unarchive() failing with corrupt 7z archives
+func TestUnarchiveSevenZipErrors(t *testing.T) {
+	dir := t.TempDir()
+	data, err := os.ReadFile(filepath.Join("testdata", "bsdtar-lzma2.7z"))
+	assert.NoError(t, err)
+
+	// Assert Unit Test
+	truncated := filepath.Join(dir, "truncated.7z")
+	assert.NoError(t, os.WriteFile(truncated, data[:len(data)/2], 0644))
+	assert.Error(t, Unarchive(truncated, filepath.Join(dir, "truncated")))
+	_, err = List(truncated)
+	assert.Error(t, err)
+	assert.Error(t, Unarchive(filepath.Join(dir, "missing.7z"), filepath.Join(dir, "missing")))
+
+	// Assert Extraction Limits
+	err = Unarchive(filepath.Join("testdata", "bsdtar-lzma2.7z"), filepath.Join(dir, "limited"), UnarchiveOptions{MaxEntrySize: 100})
+	assert.True(t, errors.Is(err, ErrLimitExceeded), err)
+	assert.NoFileExists(t, filepath.Join(dir, "limited", "lorem.txt"))
+}
@@ -0,0 +1,209 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/knowntraveler/gogo/fs"
+)
+
+// Source is a file or directory on the local filesystem (or in an io/fs.FS) added to an archive
+type Source struct {
+	// Path is the file or directory to add, the contents of a directory are
+	// added without the directory itself
+	Path string
+
+	// Prefix is the directory inside the archive the source is added to
+	// (e.g. "docs" adds README.md as docs/README.md, default the root)
+	Prefix string
+
+	// FS reads the source from an io/fs.FS (e.g. an embed.FS of templates or a
+	// generated fstest.MapFS) instead of the local filesystem, Path is then a
+	// slash-separated path in the FS ("." for its root)
+	FS iofs.FS
+}
+
+// readLinkFS is an io/fs.FS that can read the targets of symbolic links (e.g. os.DirFS or fs.MemFS)
+type readLinkFS interface {
+	ReadLink(name string) (string, error)
+}
+
+// validateSources checks that sources were given and their prefixes stay inside the archive
+func validateSources(sources []Source) error {
+	if len(sources) == 0 {
+		return fmt.Errorf("The 'sources' parameter was empty. A source is required to create an Archive")
+	}
+	for _, source := range sources {
+		if source.Path == "" {
+			return fmt.Errorf("The 'source' parameter was empty. A source is required to create an Archive")
+		}
+		if _, err := fs.SanitizePath(".", source.Prefix); err != nil {
+			return fmt.Errorf("Source prefix '%v' is unsafe: %v", source.Prefix, err)
+		}
+		if source.FS != nil && !iofs.ValidPath(source.Path) {
+			return fmt.Errorf("Source path '%v' is not a valid io/fs path", source.Path)
+		}
+	}
+	return nil
+}
+
+// relPath returns a path below the Source relative to a directory source ("." for the
+// directory itself) or the base name of a file source
+func (source Source) relPath(file string) (string, error) {
+
+	// Trim Path of FS Sources
+	if source.FS != nil {
+		switch {
+		case file == source.Path:
+			if info, err := source.stat(); err == nil && !info.IsDir() {
+				return path.Base(file), nil
+			}
+			return ".", nil
+		case source.Path == ".":
+			return file, nil
+		}
+		return strings.TrimPrefix(file, source.Path+"/"), nil
+	}
+
+	rel, err := filepath.Rel(source.Path, file)
+	if err != nil {
+		return "", err
+	}
+	if rel == "." {
+		if info, err := os.Stat(source.Path); err == nil && !info.IsDir() {
+			return filepath.Base(file), nil
+		}
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// entryName returns the name of the archive entry for a path relative to the Source
+func (source Source) entryName(rel string) string {
+	return path.Join(filepath.ToSlash(source.Prefix), rel)
+}
+
+// stat returns the FileInfo of the Source
+func (source Source) stat() (os.FileInfo, error) {
+	if source.FS != nil {
+		return iofs.Stat(source.FS, source.Path)
+	}
+	return os.Stat(source.Path)
+}
+
+// walk walks the file tree of the Source like filepath.Walk (symbolic links are not
+// followed), adding the path of the file to the errors returned by fn
+func (source Source) walk(fn filepath.WalkFunc) error {
+	if source.FS == nil {
+		return filepath.Walk(source.Path, func(file string, info os.FileInfo, err error) error {
+			return walkError(file, fn(file, info, err))
+		})
+	}
+	return iofs.WalkDir(source.FS, source.Path, func(file string, entry iofs.DirEntry, err error) error {
+		if err != nil {
+			return walkError(file, fn(file, nil, err))
+		}
+		info, err := entry.Info()
+		return walkError(file, fn(file, info, err))
+	})
+}
+
+// walkError adds the path of the file being walked to an error, errors already naming
+// the file (such as an *os.PathError from opening it), the filepath.SkipDir and
+// filepath.SkipAll sentinels and context errors are returned as is
+func walkError(file string, err error) error {
+	var pathErr *os.PathError
+	switch {
+	case err == nil, err == filepath.SkipDir, err == filepath.SkipAll, err == context.Canceled, err == context.DeadlineExceeded:
+		return err
+	case errors.As(err, &pathErr) && pathErr.Path == file:
+		return err
+	}
+	return fmt.Errorf("Unable to archive '%v': %w", file, err)
+}
+
+// open opens a file below the Source
+func (source Source) open(file string) (io.ReadCloser, error) {
+	if source.FS != nil {
+		return source.FS.Open(file)
+	}
+	return os.Open(file)
+}
+
+// readLink returns the target of a symbolic link below the Source
+func (source Source) readLink(file string) (string, error) {
+	if source.FS == nil {
+		return os.Readlink(file)
+	}
+	if fsys, ok := source.FS.(readLinkFS); ok {
+		return fsys.ReadLink(file)
+	}
+	return "", fmt.Errorf("Symbolic link '%v' can't be read from the source FS", file)
+}
+
+// entrySet records the names of the entries written to an archive
+type entrySet map[string]bool
+
+// add records an entry name, reporting whether it is new. Directories may be added by
+// several sources (and are only written once) but files must have unique names.
+func (entries entrySet) add(name string, isDir bool) (bool, error) {
+	if entries[name] {
+		if isDir {
+			return false, nil
+		}
+		return false, fmt.Errorf("Archive entry '%v' is added by more than one source", name)
+	}
+	entries[name] = true
+	return true, nil
+}
+
+// walkSources calls fn for each file, directory and symbolic link below sources that is
+// added to an archive, with its Source and entry name (directories end in "/"), skipping
+// filtered paths and directories added by more than one source
+func walkSources(sources []Source, filter *archiveFilter, fn func(source Source, name string, path string, info os.FileInfo) error) error {
+	entries := entrySet{}
+	for _, source := range sources {
+		err := source.walk(func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			rel, err := source.relPath(path)
+			if err != nil || rel == "." {
+				return err
+			}
+			if filter.skip(rel, info.IsDir()) {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			name := source.entryName(rel)
+			added, err := entries.add(name, info.IsDir())
+			if err != nil || !added {
+				return err
+			}
+			if info.IsDir() {
+				name += "/"
+			}
+			return fn(source, name, path, info)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
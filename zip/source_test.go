@@ -0,0 +1,131 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"context"
+	"errors"
+	iofs "io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// unreadableFS is an FS whose file bad/unreadable.txt fails to open
+type unreadableFS struct {
+	fstest.MapFS
+}
+
+// Open fails for bad/unreadable.txt
+func (fsys unreadableFS) Open(name string) (iofs.File, error) {
+	if name == "bad/unreadable.txt" {
+		return nil, errors.New("device not ready")
+	}
+	return fsys.MapFS.Open(name)
+}
+
+// TestWalkError is a unit test for the errors returned while walking a zip.Source
+func TestWalkError(t *testing.T) {
+	pathErr := &os.PathError{Op: "open", Path: "a.txt", Err: os.ErrPermission}
+
+	// Assert Unit Test
+	assert.Nil(t, walkError("a.txt", nil))
+	for _, err := range []error{filepath.SkipDir, filepath.SkipAll, context.Canceled, context.DeadlineExceeded, pathErr} {
+		assert.Equal(t, err, walkError("a.txt", err))
+	}
+	err := walkError("a.txt", errors.New("device not ready"))
+	assert.EqualError(t, err, "Unable to archive 'a.txt': device not ready")
+	assert.ErrorContains(t, walkError("b.txt", pathErr), "Unable to archive 'b.txt'")
+	assert.True(t, errors.Is(walkError("b.txt", pathErr), os.ErrPermission))
+}
+
+// TestArchiveUnreadable is a unit test for zip.Archive() failing on an unreadable file and removing the partial archive
+func TestArchiveUnreadable(t *testing.T) {
+	dir := t.TempDir()
+	fsys := unreadableFS{fstest.MapFS{
+		"a.txt":                 {Data: make([]byte, 5000)},
+		"bad/unreadable.txt":    {Data: []byte("unreadable")},
+		"bad/zz-not-reached.md": {Data: []byte("later")},
+	}}
+
+	for _, name := range []string{"fs.zip", "fs.tar.gz"} {
+		for _, split := range []int64{0, 100} {
+			target := filepath.Join(dir, "out", name)
+			assert.NoError(t, os.MkdirAll(filepath.Dir(target), 0755))
+			err := ArchiveFS(fsys, target, ArchiveOptions{SplitSize: split, Level: Store})
+
+			// Assert Unit Test
+			assert.ErrorContains(t, err, "Unable to archive 'bad/unreadable.txt': device not ready", name)
+			files, _ := filepath.Glob(filepath.Join(dir, "out", "*"))
+			assert.Empty(t, files, "%v split %v", name, split)
+		}
+	}
+
+	// Assert a File without Read Permission fails the Archive
+	if runtime.GOOS == "windows" || os.Geteuid() == 0 {
+		t.Skip("file permissions don't prevent reading")
+	}
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "alpha", "secret.txt": "secret"})
+	assert.NoError(t, os.Chmod(filepath.Join(source, "secret.txt"), 0))
+	target := filepath.Join(dir, "permission.zip")
+	err := Archive(source, target)
+	assert.True(t, errors.Is(err, os.ErrPermission), err)
+	assert.NoFileExists(t, target)
+}
+
+// TestArchiveSources is a unit test for zip.ArchiveSources() with archive prefixes
+func TestArchiveSources(t *testing.T) {
+	dir := t.TempDir()
+	writeTestTree(t, dir, map[string]string{"build/app": "binary", "LICENSE": "MPL", "docs/guide/a.md": "guide"})
+	sources := []Source{
+		{Path: filepath.Join(dir, "build", "app"), Prefix: "bin"},
+		{Path: filepath.Join(dir, "LICENSE")},
+		{Path: filepath.Join(dir, "docs"), Prefix: "share/docs"},
+	}
+
+	for _, name := range []string{"release.zip", "release.tar.gz"} {
+		target := filepath.Join(dir, name)
+
+		// Assert Unit Test
+		assert.NoError(t, ArchiveSources(sources, target))
+		assert.Equal(t, []string{"LICENSE", "bin/app", "share/docs/guide/", "share/docs/guide/a.md"}, archiveNames(t, target), name)
+		out := filepath.Join(dir, "out-"+name)
+		assert.NoError(t, Unarchive(target, out))
+		data, err := os.ReadFile(filepath.Join(out, "share", "docs", "guide", "a.md"))
+		assert.NoError(t, err)
+		assert.Equal(t, "guide", string(data))
+	}
+}
+
+// TestArchiveSourcesErrors is a unit test for zip.ArchiveSources() rejecting missing sources, duplicate entries and unsafe prefixes
+func TestArchiveSourcesErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeTestTree(t, dir, map[string]string{"LICENSE": "MPL"})
+	license := filepath.Join(dir, "LICENSE")
+
+	for name, sources := range map[string][]Source{
+		"none":      nil,
+		"empty":     {{Path: ""}},
+		"missing":   {{Path: filepath.Join(dir, "missing")}},
+		"duplicate": {{Path: license}, {Path: license}},
+		"parent":    {{Path: license, Prefix: "../x"}},
+		"absolute":  {{Path: license, Prefix: "/etc"}},
+	} {
+		target := filepath.Join(dir, name+".zip")
+
+		// Assert Unit Test
+		assert.Error(t, ArchiveSources(sources, target), name)
+		assert.NoFileExists(t, target, name)
+	}
+}
@@ -0,0 +1,437 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Split Archives are written as parts of a fixed size named target.001, target.002, ...
+// (the format read by 7-Zip and by joining the parts with cat), spanned zip archives
+// written by Info-ZIP (zip -s) as .z01, .z02, ... with the last part named .zip
+const (
+	firstPart      = ".001"
+	eocdSignature  = 0x06054b50 // end of central directory record
+	eocdSize       = 22
+	cdSignature    = 0x02014b50 // central directory file header
+	cdHeaderSize   = 46
+	maxCommentSize = 0xffff
+)
+
+// partName returns the name of a part of a split archive (1 for target.001)
+func partName(target string, part int) string {
+	return fmt.Sprintf("%s.%03d", target, part)
+}
+
+// trimPart returns the archive name of the first part of a split archive (e.g.
+// "release.zip" for "release.zip.001") or the name itself
+func trimPart(name string) string {
+	return strings.TrimSuffix(name, firstPart)
+}
+
+// splitWriter is an io.WriteCloser writing an archive as parts of at most size bytes
+type splitWriter struct {
+	target  string
+	size    int64
+	parts   []string
+	file    *os.File
+	written int64
+}
+
+// createOutput creates the file an archive is written to, or a splitWriter writing the
+// parts target.001, target.002, ... when ArchiveOptions.SplitSize is set
+func createOutput(target string, options ArchiveOptions) (io.WriteCloser, error) {
+	if options.SplitSize <= 0 {
+		return os.Create(target)
+	}
+	return &splitWriter{target: target, size: options.SplitSize}, nil
+}
+
+// removeOutput removes a partially written archive (or all its parts)
+func removeOutput(output io.WriteCloser, target string) {
+	if split, ok := output.(*splitWriter); ok {
+		for _, part := range split.parts {
+			os.Remove(part)
+		}
+		return
+	}
+	os.Remove(target)
+}
+
+// Write writes to the current part, starting a new part once it is full
+func (w *splitWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if w.file == nil || w.written == w.size {
+			err := w.nextPart()
+			if err != nil {
+				return written, err
+			}
+		}
+		chunk := p
+		if int64(len(chunk)) > w.size-w.written {
+			chunk = chunk[:w.size-w.written]
+		}
+		n, err := w.file.Write(chunk)
+		written += n
+		w.written += int64(n)
+		if err != nil {
+			return written, err
+		}
+		p = p[n:]
+	}
+	return written, nil
+}
+
+// nextPart closes the current part and creates the next one
+func (w *splitWriter) nextPart() error {
+	if w.file != nil {
+		if err := w.file.Close(); err != nil {
+			return err
+		}
+	}
+	name := partName(w.target, len(w.parts)+1)
+	file, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	w.parts = append(w.parts, name)
+	w.file, w.written = file, 0
+	return nil
+}
+
+// Close closes the last part (creating an empty first part for an empty archive)
+func (w *splitWriter) Close() error {
+	if w.file == nil {
+		if err := w.nextPart(); err != nil {
+			return err
+		}
+	}
+	return w.file.Close()
+}
+
+// archiveFile is an archive opened for reading, either a file or the parts of a split archive
+type archiveFile interface {
+	io.Reader
+	io.ReaderAt
+	io.Closer
+}
+
+// openArchiveFile opens an archive, joining the parts of a split archive (.001, .002, ...)
+func openArchiveFile(source string) (archiveFile, int64, error) {
+	if strings.HasSuffix(source, firstPart) {
+		return openParts(trimPart(source))
+	}
+
+	file, err := os.Open(source)
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, err
+	}
+	return file, info.Size(), nil
+}
+
+// openParts opens the parts target.001, target.002, ... of a split archive
+func openParts(target string) (archiveFile, int64, error) {
+	var names []string
+	for part := 1; ; part++ {
+		name := partName(target, part)
+		_, err := os.Stat(name)
+		if errors.Is(err, os.ErrNotExist) {
+			break
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("Unable to read part '%v': %w", name, err)
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return nil, 0, fmt.Errorf("File '%v' doesn't exist", partName(target, 1))
+	}
+
+	// Check for Missing Parts (a later part exists after a gap)
+	parts, _ := filepath.Glob(target + ".[0-9][0-9][0-9]")
+	if len(parts) > len(names) {
+		return nil, 0, fmt.Errorf("Part '%v' of split archive '%v' is missing", partName(target, len(names)+1), target)
+	}
+
+	reader, err := openMultiReader(names)
+	if err != nil {
+		return nil, 0, err
+	}
+	return reader, reader.size, nil
+}
+
+// openZipArchive opens a zip archive for reading, including split (.zip.001) and
+// spanned Info-ZIP archives (.z01, .z02, ..., .zip)
+func openZipArchive(source string) (*zip.Reader, io.Closer, error) {
+	file, size, err := openArchiveFile(source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Join Spanned Archives
+	if disk, ok := eocdDisk(file, size); ok && disk > 0 {
+		file.Close()
+		spanned, err := openSpanned(source, disk)
+		if err != nil {
+			return nil, nil, err
+		}
+		file, size = spanned, spanned.size
+	}
+
+	reader, err := zip.NewReader(file, size)
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	return reader, file, nil
+}
+
+// findEOCD returns the offset of the end of central directory record of a zip archive
+func findEOCD(r io.ReaderAt, size int64) (int64, bool) {
+	start := size - eocdSize - maxCommentSize
+	if start < 0 {
+		start = 0
+	}
+	buf := make([]byte, size-start)
+	if _, err := r.ReadAt(buf, start); err != nil && err != io.EOF {
+		return 0, false
+	}
+	for i := len(buf) - eocdSize; i >= 0; i-- {
+		if binary.LittleEndian.Uint32(buf[i:]) == eocdSignature {
+			return start + int64(i), true
+		}
+	}
+	return 0, false
+}
+
+// eocdDisk returns the disk number of the end of central directory record of a zip
+// archive, the last part of a spanned archive has a disk number above 0
+func eocdDisk(r io.ReaderAt, size int64) (int, bool) {
+	offset, ok := findEOCD(r, size)
+	if !ok {
+		return 0, false
+	}
+	buf := make([]byte, 2)
+	if _, err := r.ReadAt(buf, offset+4); err != nil {
+		return 0, false
+	}
+	return int(binary.LittleEndian.Uint16(buf)), true
+}
+
+// openSpanned joins the parts of a spanned zip archive written by Info-ZIP, whose
+// central directory records per-part offsets, followed by a rewritten central
+// directory with offsets into the joined parts
+func openSpanned(source string, disks int) (*multiReader, error) {
+	base := strings.TrimSuffix(source, ".zip")
+	var names []string
+	for disk := 1; disk <= disks; disk++ {
+		names = append(names, fmt.Sprintf("%s.z%02d", base, disk))
+	}
+	names = append(names, source)
+	for _, name := range names {
+		if _, err := os.Stat(name); err != nil {
+			return nil, fmt.Errorf("Part '%v' of spanned archive '%v' is missing", name, source)
+		}
+	}
+	joined, err := openMultiReader(names)
+	if err != nil {
+		return nil, err
+	}
+
+	directory, err := rewriteDirectory(joined)
+	if err != nil {
+		joined.Close()
+		return nil, fmt.Errorf("Spanned archive '%v' is invalid: %w", source, err)
+	}
+	joined.append(bytes.NewReader(directory), int64(len(directory)))
+	return joined, nil
+}
+
+// rewriteDirectory reads the central directory of joined spanned parts and returns it
+// with the offsets of each entry relative to the start of the joined parts
+func rewriteDirectory(joined *multiReader) ([]byte, error) {
+
+	// Read End of Central Directory Record
+	offset, ok := findEOCD(joined, joined.size)
+	if !ok {
+		return nil, zip.ErrFormat
+	}
+	eocd := make([]byte, eocdSize)
+	if _, err := joined.ReadAt(eocd, offset); err != nil {
+		return nil, err
+	}
+	directoryDisk := int(binary.LittleEndian.Uint16(eocd[6:]))
+	entries := binary.LittleEndian.Uint16(eocd[10:])
+	directorySize := binary.LittleEndian.Uint32(eocd[12:])
+	directoryOffset := binary.LittleEndian.Uint32(eocd[16:])
+	if entries == 0xffff || directorySize == 0xffffffff || directoryOffset == 0xffffffff {
+		return nil, errors.New("zip64 spanned archives are not supported")
+	}
+	if directoryDisk >= len(joined.offsets) {
+		return nil, zip.ErrFormat
+	}
+
+	// Read Central Directory
+	directory := make([]byte, directorySize)
+	_, err := joined.ReadAt(directory, joined.offsets[directoryDisk]+int64(directoryOffset))
+	if err != nil {
+		return nil, err
+	}
+
+	// Rewrite Disk Numbers and Offsets of each Entry
+	for i, n := 0, 0; n < int(entries); n++ {
+		if i+cdHeaderSize > len(directory) || binary.LittleEndian.Uint32(directory[i:]) != cdSignature {
+			return nil, zip.ErrFormat
+		}
+		disk := int(binary.LittleEndian.Uint16(directory[i+34:]))
+		local := int64(binary.LittleEndian.Uint32(directory[i+42:]))
+		if disk == 0xffff || local == 0xffffffff || disk >= len(joined.offsets) {
+			return nil, errors.New("zip64 spanned archives are not supported")
+		}
+		local += joined.offsets[disk]
+		if local >= 0xffffffff {
+			return nil, errors.New("spanned archives larger than 4 GB are not supported")
+		}
+		binary.LittleEndian.PutUint16(directory[i+34:], 0)
+		binary.LittleEndian.PutUint32(directory[i+42:], uint32(local))
+
+		nameSize := int(binary.LittleEndian.Uint16(directory[i+28:]))
+		extraSize := int(binary.LittleEndian.Uint16(directory[i+30:]))
+		commentSize := int(binary.LittleEndian.Uint16(directory[i+32:]))
+		i += cdHeaderSize + nameSize + extraSize + commentSize
+	}
+
+	// Write End of Central Directory Record for the Joined Parts
+	if joined.size >= 0xffffffff {
+		return nil, errors.New("spanned archives larger than 4 GB are not supported")
+	}
+	end := make([]byte, eocdSize)
+	binary.LittleEndian.PutUint32(end[0:], eocdSignature)
+	binary.LittleEndian.PutUint16(end[8:], entries)
+	binary.LittleEndian.PutUint16(end[10:], entries)
+	binary.LittleEndian.PutUint32(end[12:], directorySize)
+	binary.LittleEndian.PutUint32(end[16:], uint32(joined.size))
+
+	return append(directory, end...), nil
+}
+
+// multiReader is an io.ReaderAt and io.Reader over the concatenated parts of an archive
+type multiReader struct {
+	parts   []io.ReaderAt
+	offsets []int64
+	size    int64
+	read    int64
+	files   []*os.File
+}
+
+// openMultiReader opens the parts of an archive in order
+func openMultiReader(names []string) (*multiReader, error) {
+	reader := &multiReader{}
+	for _, name := range names {
+		file, err := os.Open(name)
+		if err != nil {
+			reader.Close()
+			return nil, err
+		}
+		reader.files = append(reader.files, file)
+		info, err := file.Stat()
+		if err != nil {
+			reader.Close()
+			return nil, err
+		}
+		reader.append(file, info.Size())
+	}
+	return reader, nil
+}
+
+// append adds a part of size bytes
+func (r *multiReader) append(part io.ReaderAt, size int64) {
+	r.parts = append(r.parts, part)
+	r.offsets = append(r.offsets, r.size)
+	r.size += size
+}
+
+// ReadAt reads from the parts containing the offset
+func (r *multiReader) ReadAt(p []byte, offset int64) (int, error) {
+	if offset < 0 {
+		return 0, errors.New("negative offset")
+	}
+	read := 0
+	for i := range r.parts {
+		if len(p) == 0 {
+			break
+		}
+		end := r.size
+		if i+1 < len(r.parts) {
+			end = r.offsets[i+1]
+		}
+		if offset >= end {
+			continue
+		}
+		chunk := p
+		if int64(len(chunk)) > end-offset {
+			chunk = chunk[:end-offset]
+		}
+		n, err := r.parts[i].ReadAt(chunk, offset-r.offsets[i])
+		read += n
+		offset += int64(n)
+		p = p[n:]
+		if n < len(chunk) {
+			if err == nil || err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return read, err
+		}
+	}
+	if len(p) > 0 {
+		return read, io.EOF
+	}
+	return read, nil
+}
+
+// Read reads the parts in order
+func (r *multiReader) Read(p []byte) (int, error) {
+	if r.read >= r.size {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > r.size-r.read {
+		p = p[:r.size-r.read]
+	}
+	n, err := r.ReadAt(p, r.read)
+	r.read += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+// Close closes the files of the parts
+func (r *multiReader) Close() error {
+	var err error
+	for _, file := range r.files {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
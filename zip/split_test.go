@@ -0,0 +1,110 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSplitArchive is a unit test for zip.Archive() with ArchiveOptions.SplitSize and reading the parts back
+func TestSplitArchive(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "alpha"})
+	random := make([]byte, 250000)
+	_, err := rand.Read(random)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(source, "random.bin"), random, 0644))
+
+	for _, name := range []string{"split.zip", "split.tar", "split.tar.gz"} {
+		target := filepath.Join(dir, name)
+		assert.NoError(t, Archive(source, target, ArchiveOptions{SplitSize: 100000}))
+
+		// Assert Parts are Written
+		assert.NoFileExists(t, target)
+		parts, err := filepath.Glob(target + ".*")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{target + ".001", target + ".002", target + ".003"}, parts, name)
+		for _, part := range parts[:len(parts)-1] {
+			info, err := os.Stat(part)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100000), info.Size(), part)
+		}
+
+		// Assert Parts are Read Back
+		out := filepath.Join(dir, "out-"+name)
+		assert.NoError(t, Unarchive(target+".001", out), name)
+		data, err := os.ReadFile(filepath.Join(out, "random.bin"))
+		assert.NoError(t, err)
+		assert.Equal(t, sha256.Sum256(random), sha256.Sum256(data), name)
+		entries, err := List(target + ".001")
+		assert.NoError(t, err)
+		assert.Len(t, entries, 2, name)
+		assert.NoError(t, Verify(target+".001"), name)
+
+		// Assert Missing Parts are Reported
+		assert.NoError(t, os.Rename(target+".002", target+".moved"))
+		assert.ErrorContains(t, Unarchive(target+".001", filepath.Join(dir, "gap-"+name)), "Part '"+target+".002' of split archive", name)
+		assert.NoError(t, os.Rename(target+".moved", target+".002"))
+		assert.NoError(t, os.Remove(target+".003"))
+		assert.Error(t, Unarchive(target+".001", filepath.Join(dir, "last-"+name)), name)
+	}
+	assert.ErrorContains(t, Unarchive(filepath.Join(dir, "missing.zip.001"), dir), "doesn't exist")
+	if runtime.GOOS != "windows" {
+		notDir := filepath.Join(dir, "split.zip.001", "nested.zip.001")
+		err := Unarchive(notDir, filepath.Join(dir, "nested"))
+		assert.True(t, errors.Is(err, syscall.ENOTDIR), err)
+		assert.NotContains(t, err.Error(), "doesn't exist")
+	}
+
+	// Assert a Failed Archive leaves no Parts
+	assert.Error(t, Archive(filepath.Join(dir, "missing"), filepath.Join(dir, "failed.zip"), ArchiveOptions{SplitSize: 10}))
+	parts, err := filepath.Glob(filepath.Join(dir, "failed.zip*"))
+	assert.NoError(t, err)
+	assert.Empty(t, parts)
+}
+
+// TestSpannedArchive is a unit test for zip.Unarchive() with a spanned archive written by Info-ZIP (zip -s 64k)
+func TestSpannedArchive(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"spanned.z01", "spanned.z02", "spanned.zip"} {
+		data, err := os.ReadFile(filepath.Join("testdata", name))
+		assert.NoError(t, err)
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0644))
+	}
+	archive := filepath.Join(dir, "spanned.zip")
+
+	// Assert Unit Test
+	out := filepath.Join(dir, "out")
+	assert.NoError(t, Unarchive(archive, out))
+	data, err := os.ReadFile(filepath.Join(out, "data.bin"))
+	assert.NoError(t, err)
+	assert.Equal(t, "e61ffdbef7233296a15e0217c93c0b20b9cc92076d563638a55ed84b4f84294b", fmt.Sprintf("%x", sha256.Sum256(data)))
+	data, err = os.ReadFile(filepath.Join(out, "small.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "spanned by Info-ZIP\n", string(data))
+	entries, err := List(archive)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.NoError(t, Verify(archive))
+
+	// Assert a Missing Part is Reported
+	assert.NoError(t, os.Remove(filepath.Join(dir, "spanned.z02")))
+	assert.ErrorContains(t, Unarchive(archive, filepath.Join(dir, "missing")), "Part '"+filepath.Join(dir, "spanned.z02")+"' of spanned archive")
+}
@@ -0,0 +1,69 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// ArchiveTo Function for Streaming a Zip Archive of sources on local filesystem to a
+// writer (e.g. an http.ResponseWriter, an upload or a pipe) without writing a
+// temporary file. The writer is not closed.
+func ArchiveTo(w io.Writer, sources []Source, options ...ArchiveOptions) error {
+
+	// Validate Writer Parameter
+	if w == nil {
+		return fmt.Errorf("The 'w' parameter was nil. A writer is required to stream a Zip Archive")
+	}
+
+	// Validate Sources Parameter
+	err := validateSources(sources)
+	if err != nil {
+		return err
+	}
+
+	// Apply Archive Options
+	archiveOptions := ArchiveOptions{}
+	if len(options) > 0 {
+		archiveOptions = options[0]
+	}
+
+	return writeZip(context.Background(), w, sources, archiveOptions)
+}
+
+// ArchiveTarTo Function for Streaming a Tar Archive of sources on local filesystem to a
+// writer, compressed with the Compressor (e.g. from CompressorFor("release.tar.gz"))
+// unless it is nil. The writer is not closed.
+func ArchiveTarTo(w io.Writer, compressor Compressor, sources []Source, options ...ArchiveOptions) error {
+
+	// Validate Writer Parameter
+	if w == nil {
+		return fmt.Errorf("The 'w' parameter was nil. A writer is required to stream a Tar Archive")
+	}
+
+	// Validate Sources Parameter
+	err := validateSources(sources)
+	if err != nil {
+		return err
+	}
+
+	// Apply Archive Options
+	archiveOptions := ArchiveOptions{}
+	if len(options) > 0 {
+		archiveOptions = options[0]
+	}
+
+	if archiveOptions.Password != "" {
+		return fmt.Errorf("Tar archives can't be encrypted, passwords are only supported for zip archives")
+	}
+
+	return writeTar(context.Background(), w, sources, compressor, archiveOptions)
+}
@@ -0,0 +1,96 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// failingWriter is an io.Writer failing after a number of bytes
+type failingWriter struct {
+	remaining int
+}
+
+// Write discards p, failing once the remaining bytes are used up
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.remaining {
+		return 0, errors.New("connection reset")
+	}
+	w.remaining -= len(p)
+	return len(p), nil
+}
+
+// TestArchiveTo is a unit test for zip.ArchiveTo() streaming to a HTTP response
+func TestArchiveTo(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "hello", "sub/b.txt": ""})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, ArchiveTo(w, []Source{{Path: source, Prefix: "pkg"}}))
+	}))
+	defer server.Close()
+
+	// Assert Unit Test
+	response, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	data, err := io.ReadAll(response.Body)
+	assert.NoError(t, err)
+	response.Body.Close()
+	archive := filepath.Join(dir, "streamed.zip")
+	assert.NoError(t, os.WriteFile(archive, data, 0644))
+	assert.Equal(t, []string{"pkg/a.txt", "pkg/sub/", "pkg/sub/b.txt"}, archiveNames(t, archive))
+	contents, err := ReadEntry(archive, "pkg/a.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(contents))
+}
+
+// TestArchiveTarTo is a unit test for zip.ArchiveTarTo()
+func TestArchiveTarTo(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "hello", "sub/b.txt": ""})
+
+	for _, name := range []string{"streamed.tar", "streamed.tar.zst"} {
+		compressor, _ := CompressorFor(name)
+		var buffer bytes.Buffer
+
+		// Assert Unit Test
+		assert.NoError(t, ArchiveTarTo(&buffer, compressor, []Source{{Path: source}}))
+		archive := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(archive, buffer.Bytes(), 0644))
+		assert.Equal(t, []string{"a.txt", "sub/", "sub/b.txt"}, archiveNames(t, archive), name)
+	}
+}
+
+// TestArchiveToErrors is a unit test for zip.ArchiveTo() and zip.ArchiveTarTo() failing
+func TestArchiveToErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeTestTree(t, dir, map[string]string{"a.bin": string(make([]byte, 100000))})
+	sources := []Source{{Path: dir}}
+	gzip, _ := CompressorFor("a.tar.gz")
+
+	// Assert Unit Test
+	assert.ErrorContains(t, ArchiveTo(nil, sources), "The 'w' parameter was nil")
+	assert.ErrorContains(t, ArchiveTarTo(nil, gzip, sources), "The 'w' parameter was nil")
+	assert.ErrorContains(t, ArchiveTo(io.Discard, nil), "The 'sources' parameter was empty")
+	assert.ErrorContains(t, ArchiveTarTo(io.Discard, gzip, sources, ArchiveOptions{Password: "secret"}), "can't be encrypted")
+
+	// Assert Write Errors are Returned
+	assert.ErrorContains(t, ArchiveTo(&failingWriter{remaining: 1000}, sources, ArchiveOptions{Level: Store}), "connection reset")
+	assert.ErrorContains(t, ArchiveTarTo(&failingWriter{remaining: 1000}, nil, sources), "connection reset")
+}
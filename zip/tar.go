@@ -0,0 +1,376 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// createTar Function for Creating a Tar Archive File (.tar, .tar.gz, .tar.zst, ...) from
+// sources on local filesystem, removing the partial archive on failure
+func createTar(ctx context.Context, sources []Source, target string, compressor Compressor, options ArchiveOptions) error {
+
+	// Verify Sources Exist
+	for _, source := range sources {
+		_, err := source.stat()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create Tar Archive File (or the parts of a split archive)
+	tarfile, err := createOutput(target, options)
+	if err != nil {
+		return err
+	}
+
+	// Write Archive
+	err = writeTar(ctx, tarfile, sources, compressor, options)
+	if closeErr := tarfile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		removeOutput(tarfile, target)
+		return err
+	}
+
+	return nil
+}
+
+// writeTar writes the files, directories and symbolic links below sources to a
+// tar stream, compressed with the Compressor unless it is nil
+func writeTar(ctx context.Context, w io.Writer, sources []Source, compressor Compressor, options ArchiveOptions) error {
+
+	// Create Archive Filter
+	filter, err := newArchiveFilter(options)
+	if err != nil {
+		return err
+	}
+
+	// Find Duplicate Files
+	duplicates, err := findDuplicates(sources, filter, options)
+	if err != nil {
+		return err
+	}
+
+	// Count Entries for Progress Callbacks
+	progress, err := newArchiveProgress(sources, filter, duplicates, options)
+	if err != nil {
+		return err
+	}
+
+	// Create Compressed Writer
+	var compressed io.WriteCloser
+	if compressor != nil {
+		compressed, err = compressWriter(compressor, w, options.Level)
+		if err != nil {
+			return fmt.Errorf("Unable to create %v archive: %w", compressor.Name(), err)
+		}
+		w = compressed
+	}
+
+	// Create New Writer for Tar Stream
+	t := &tarArchiver{
+		ctx:        ctx,
+		archive:    tar.NewWriter(w),
+		filter:     filter,
+		entries:    entrySet{},
+		duplicates: duplicates,
+		progress:   progress,
+		options:    options,
+	}
+
+	// Write Sources
+	for _, source := range sources {
+		err = t.writeSource(source)
+		if err != nil {
+			break
+		}
+	}
+
+	// Flush Archive
+	if closeErr := t.archive.Close(); err == nil {
+		err = closeErr
+	}
+	if compressed != nil {
+		if closeErr := compressed.Close(); err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
+
+// tarArchiver holds the state of writing sources to a tar.Writer
+type tarArchiver struct {
+	ctx        context.Context
+	archive    *tar.Writer
+	filter     *archiveFilter
+	entries    entrySet
+	duplicates map[string]string
+	progress   *archiveProgress
+	options    ArchiveOptions
+}
+
+// writeSource writes the files, directories and symbolic links below a source to the archive
+func (t *tarArchiver) writeSource(source Source) error {
+	return source.walk(func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if t.ctx.Err() != nil {
+			return t.ctx.Err()
+		}
+
+		// Set Relative Path (the root of a directory source has no entry)
+		rel, err := source.relPath(path)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		// Skip Filtered Paths
+		if t.filter.skip(rel, info.IsDir()) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// Get File Header Info
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err = source.readLink(path)
+			if err != nil {
+				return err
+			}
+		}
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		if t.options.Reproducible {
+			normalizeTarHeader(header, t.options.ModTime)
+		}
+		header.Name = source.entryName(rel)
+		added, err := t.entries.add(header.Name, info.IsDir())
+		if err != nil || !added {
+			return err
+		}
+		if info.IsDir() {
+			header.Name += "/"
+		}
+
+		// Store Duplicate Files as Hard Links to their First Copy
+		original, duplicate := t.duplicates[header.Name]
+		if duplicate {
+			header.Typeflag = tar.TypeLink
+			header.Linkname = original
+			header.Size = 0
+		}
+		t.progress.entry(header.Name)
+
+		// Create Header for Source File
+		err = t.archive.WriteHeader(header)
+		if err != nil || !info.Mode().IsRegular() || duplicate {
+			return err
+		}
+
+		// Open Source File
+		file, err := source.open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		// Copy Source File to Archive
+		_, err = io.Copy(t.archive, t.progress.reader(contextReader{t.ctx, file}))
+		return err
+	})
+}
+
+// writeEntry writes an archive entry with its contents to the archive (the target of
+// a symbolic link is Entry.Link, a file with a Link and no contents is a hard link).
+// Contents of unknown size (Entry.Size -1) are buffered in a temporary file, as tar
+// headers record the size before the contents. Special files are not written.
+func (t *tarArchiver) writeEntry(entry Entry, contents io.Reader) error {
+	mode := entry.Mode
+	if !mode.IsDir() && !mode.IsRegular() && mode&os.ModeSymlink == 0 {
+		return nil
+	}
+
+	// Set Archive File Header
+	name := strings.TrimSuffix(entry.Name, "/")
+	added, err := t.entries.add(name, mode.IsDir())
+	if err != nil || !added {
+		return err
+	}
+	header := &tar.Header{Name: name, Mode: int64(mode.Perm()), ModTime: entry.Modified, Typeflag: tar.TypeReg}
+	if mode&os.ModeSetuid != 0 {
+		header.Mode |= 04000
+	}
+	if mode&os.ModeSetgid != 0 {
+		header.Mode |= 02000
+	}
+	if mode&os.ModeSticky != 0 {
+		header.Mode |= 01000
+	}
+	switch {
+	case mode.IsDir():
+		header.Typeflag = tar.TypeDir
+		header.Name += "/"
+		contents = nil
+	case mode&os.ModeSymlink != 0:
+		header.Typeflag = tar.TypeSymlink
+		header.Linkname = entry.Link
+		contents = nil
+	case entry.Link != "" && contents == nil:
+		header.Typeflag = tar.TypeLink
+		header.Linkname = entry.Link
+	}
+
+	// Buffer Contents of Unknown Size
+	if contents != nil {
+		header.Size = entry.Size
+		if header.Size < 0 {
+			spool, err := os.CreateTemp("", "gogo-tar-")
+			if err != nil {
+				return err
+			}
+			defer os.Remove(spool.Name())
+			defer spool.Close()
+			header.Size, err = io.Copy(spool, contextReader{t.ctx, contents})
+			if err != nil {
+				return err
+			}
+			_, err = spool.Seek(0, io.SeekStart)
+			if err != nil {
+				return err
+			}
+			contents = spool
+		}
+	}
+
+	// Create Header for Entry
+	t.progress.entry(header.Name)
+	err = t.archive.WriteHeader(header)
+	if err != nil || contents == nil {
+		return err
+	}
+
+	// Copy Contents to Archive
+	_, err = io.Copy(t.archive, t.progress.reader(contextReader{t.ctx, contents}))
+	return err
+}
+
+// unarchiveTar Function for Extracting a Tar Archive File (.tar, .tar.gz, .tar.zst, ...),
+// decompressed with the Compressor unless it is nil
+func unarchiveTar(ctx context.Context, source string, target string, compressor Compressor, options UnarchiveOptions) error {
+
+	// Open Source Archive (joining the parts of a split archive)
+	file, size, err := openArchiveFile(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return extractTar(ctx, file, size, source, target, compressor, options)
+}
+
+// extractTar extracts a tar stream of size bytes (-1 when unknown) read from r (named
+// source in errors), decompressed with the Compressor unless it is nil
+func extractTar(ctx context.Context, r io.Reader, size int64, source string, target string, compressor Compressor, options UnarchiveOptions) error {
+
+	// Report Progress of the Archive Bytes Read
+	progress := &archiveProgress{onEntry: options.OnEntry, progress: options.Progress, entries: -1, size: size}
+	r = progress.reader(r)
+	limits := newExtractLimits(options)
+
+	// Create Decompressed Reader
+	reader := r
+	if compressor != nil {
+		decompressed, err := compressor.Reader(r)
+		if err != nil {
+			return fmt.Errorf("Unable to read %v archive '%v': %v", compressor.Name(), source, err)
+		}
+		defer decompressed.Close()
+		reader = decompressed
+	}
+
+	if target == "" {
+		target = "./"
+	}
+
+	// Iterate through each Entry found in Source Archive
+	archive := tar.NewReader(reader)
+	var dirs []extractedDir
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		header, err := archive.Next()
+		if err == io.EOF {
+			return restoreDirs(dirs)
+		}
+		if err != nil {
+			return err
+		}
+		progress.entry(header.Name)
+
+		// Check Extraction Limits
+		err = limits.entry(header.Name, header.Size)
+		if err != nil {
+			return err
+		}
+
+		// Set Extracted Filepath (rejecting entries that escape the target directory)
+		extractedFilePath, err := extractPath(target, header.Name, options)
+		if err != nil {
+			return fmt.Errorf("Archive entry '%v' is unsafe: %v", header.Name, err)
+		}
+
+		// Check Existing Files (directories are merged)
+		if header.Typeflag == tar.TypeReg || header.Typeflag == tar.TypeSymlink || header.Typeflag == tar.TypeLink {
+			extract, err := checkConflict(header.Name, extractedFilePath, header.ModTime, options)
+			if err != nil {
+				return err
+			}
+			if !extract {
+				continue
+			}
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			// Create Directory (permissions and modification time are restored
+			// once all entries are extracted)
+			err = os.MkdirAll(extractedFilePath, 0755)
+			dirs = append(dirs, extractedDir{extractedFilePath, fileMode(header.FileInfo().Mode(), true), header.ModTime})
+		case tar.TypeReg:
+			// Extract Regular File
+			err = extractFile(ctx, archive, extractedFilePath, fileMode(header.FileInfo().Mode(), false), header.ModTime, nil, limits)
+		case tar.TypeSymlink:
+			// Recreate Symbolic Link
+			err = extractSymlink(target, extractedFilePath, header.Linkname, options)
+		case tar.TypeLink:
+			// Recreate Hard Link to an Extracted File
+			err = extractHardLink(target, extractedFilePath, header.Linkname, options)
+		default:
+			// Special Files are not extracted
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// SkipEntry is returned by a TransformFunc to leave an entry out of the target archive
+var SkipEntry = errors.New("skip this entry")
+
+// TransformFunc is called by Transform with each entry of the source archive and its
+// contents (nil for directories and links, a tar hard link has the name of the linked
+// entry as its Link), returning the entry written to the target archive and its
+// contents. Return the entry unchanged to copy it, change its Name to rename it, return
+// other contents to rewrite it or return SkipEntry to drop it. The Size of the returned
+// Entry is ignored (it is the size of the contents).
+type TransformFunc func(entry Entry, contents io.Reader) (Entry, io.Reader, error)
+
+// TransformOptions configure Transform
+type TransformOptions struct {
+	// Password decrypts the encrypted entries of a zip source archive and encrypted
+	// 7z and RAR archives (the target archive is not encrypted)
+	Password string
+
+	// Level is the CompressionLevel of the target archive (default DefaultCompression)
+	Level CompressionLevel
+
+	// Add are sources added after the transformed entries (e.g. a Source with an FS
+	// such as an fs.MemFS or fstest.MapFS to inject generated files)
+	Add []Source
+}
+
+// transformReader is the contents of a source entry passed to a TransformFunc, whose
+// size is known when it is returned unchanged
+type transformReader struct {
+	io.Reader
+}
+
+// Transform Function for Rewriting an Archive File (.zip, a tar archive, .7z or .rar)
+// entry by entry into a target archive (.zip or a tar archive, by its extension)
+// without extracting it to disk, e.g. to rename paths, inject files or rewrite text
+// in release artifacts. The target is written next to its path and renamed into place
+// once complete, so the source may be the target. The default extraction limits apply
+// to the source archive.
+func Transform(source string, target string, fn TransformFunc, options ...TransformOptions) error {
+
+	// Validate Source Parameter
+	if source == "" {
+		return fmt.Errorf("The 'source' parameter was empty. A source is required to transform an Archive")
+	}
+
+	// Validate Target Parameter
+	if target == "" {
+		return fmt.Errorf("The 'target' parameter was empty. A target is required to transform an Archive")
+	}
+
+	// Validate Function Parameter
+	if fn == nil {
+		return fmt.Errorf("The 'fn' parameter was nil. A function is required to transform an Archive")
+	}
+
+	// Apply Transform Options
+	transformOptions := TransformOptions{}
+	if len(options) > 0 {
+		transformOptions = options[0]
+	}
+	if len(transformOptions.Add) > 0 {
+		err := validateSources(transformOptions.Add)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Write to a Temporary Path next to the Target
+	tmp := target + ".part"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if compressor, ok := tarFormat(target); ok {
+		err = transformTar(source, file, compressor, fn, transformOptions)
+	} else {
+		err = transformZip(source, file, fn, transformOptions)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	// Rename Transformed Archive into place
+	return os.Rename(tmp, target)
+}
+
+// transformZip writes the transformed entries of the source archive to a zip stream
+func transformZip(source string, w io.Writer, fn TransformFunc, options TransformOptions) error {
+	archiveOptions := ArchiveOptions{Level: options.Level}
+	filter, err := newArchiveFilter(archiveOptions)
+	if err != nil {
+		return err
+	}
+	z := &zipArchiver{
+		ctx:     context.Background(),
+		archive: newZipWriter(w, options.Level),
+		filter:  filter,
+		entries: entrySet{},
+		options: archiveOptions,
+	}
+
+	// Write Entries
+	err = transformEntries(source, fn, options, z.writeEntry)
+	for _, add := range options.Add {
+		if err != nil {
+			break
+		}
+		err = z.writeSource(add)
+	}
+
+	// Flush Archive
+	if closeErr := z.archive.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// transformTar writes the transformed entries of the source archive to a tar stream,
+// compressed with the Compressor unless it is nil
+func transformTar(source string, w io.Writer, compressor Compressor, fn TransformFunc, options TransformOptions) error {
+	archiveOptions := ArchiveOptions{Level: options.Level}
+	filter, err := newArchiveFilter(archiveOptions)
+	if err != nil {
+		return err
+	}
+
+	// Create Compressed Writer
+	var compressed io.WriteCloser
+	if compressor != nil {
+		compressed, err = compressWriter(compressor, w, options.Level)
+		if err != nil {
+			return fmt.Errorf("Unable to create %v archive: %w", compressor.Name(), err)
+		}
+		w = compressed
+	}
+	t := &tarArchiver{
+		ctx:     context.Background(),
+		archive: tar.NewWriter(w),
+		filter:  filter,
+		entries: entrySet{},
+		options: archiveOptions,
+	}
+
+	// Write Entries
+	err = transformEntries(source, fn, options, t.writeEntry)
+	for _, add := range options.Add {
+		if err != nil {
+			break
+		}
+		err = t.writeSource(add)
+	}
+
+	// Flush Archive
+	if closeErr := t.archive.Close(); err == nil {
+		err = closeErr
+	}
+	if compressed != nil {
+		if closeErr := compressed.Close(); err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
+
+// transformEntries calls fn with each entry of the source archive and writes the
+// returned entry with write, hard links follow their linked entry when it is renamed
+func transformEntries(source string, fn TransformFunc, options TransformOptions, write func(entry Entry, contents io.Reader) error) error {
+	limits := newExtractLimits(UnarchiveOptions{})
+	names := map[string]string{}
+	return readEntries(context.Background(), source, UnarchiveOptions{Password: options.Password}, func(entry archiveEntry) error {
+
+		// Check Extraction Limits
+		err := limits.entry(entry.Name, entry.Size)
+		if err != nil {
+			return err
+		}
+
+		// Transform Entry
+		var contents io.Reader
+		if entry.contents != nil {
+			contents = &transformReader{limits.reader(entry.contents)}
+		}
+		if entry.hardLink != "" {
+			entry.Link = entry.hardLink
+		}
+		transformed, transformedContents, err := fn(entry.Entry, contents)
+		if err == SkipEntry {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("Unable to transform '%v': %w", entry.Name, err)
+		}
+
+		// Follow Renamed Entries from Hard Links
+		if entry.hardLink != "" && transformed.Link == entry.hardLink {
+			linked, ok := names[entry.hardLink]
+			if !ok {
+				return fmt.Errorf("Hard link '%v' points to '%v', which was skipped", entry.Name, entry.hardLink)
+			}
+			transformed.Link = linked
+		}
+		names[entry.Name] = transformed.Name
+
+		// Contents are Sized when Returned Unchanged
+		transformed.Size = -1
+		if reader, ok := transformedContents.(*transformReader); ok && reader == contents && entry.Size >= 0 {
+			transformed.Size = entry.Size
+		}
+
+		return write(transformed, transformedContents)
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// patchRelease is a TransformFunc dropping secret.env, moving bin/ to tools/ and patching VERSION
+func patchRelease(entry Entry, contents io.Reader) (Entry, io.Reader, error) {
+	switch {
+	case entry.Name == "secret.env":
+		return entry, nil, SkipEntry
+	case entry.Name == "bin/" || strings.HasPrefix(entry.Name, "bin/"):
+		entry.Name = "tools/" + strings.TrimPrefix(entry.Name, "bin/")
+	case entry.Name == "VERSION":
+		data, err := io.ReadAll(contents)
+		if err != nil {
+			return entry, nil, err
+		}
+		return entry, bytes.NewReader(bytes.ReplaceAll(data, []byte("v1.0.0"), []byte("v1.0.1"))), nil
+	}
+	return entry, contents, nil
+}
+
+// TestTransform is a unit test for zip.Transform()
+func TestTransform(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"VERSION": "v1.0.0", "bin/tool": "#!/bin/sh", "secret.env": "token"})
+	assert.NoError(t, os.Chmod(filepath.Join(source, "bin", "tool"), 0755))
+	add := TransformOptions{Add: []Source{{Path: ".", FS: fstest.MapFS{"NOTICE": {Data: []byte("patched")}}}}}
+
+	for _, pair := range [][2]string{{".zip", ".zip"}, {".tar.gz", ".tar.gz"}, {".tar.gz", ".zip"}, {".zip", ".tar.zst"}} {
+		archive := filepath.Join(dir, "in"+pair[0])
+		target := filepath.Join(dir, "out"+pair[1])
+		assert.NoError(t, Archive(source, archive))
+
+		// Assert Unit Test
+		assert.NoError(t, Transform(archive, target, patchRelease, add), pair)
+		assert.NoError(t, Verify(target), pair)
+		assert.NoFileExists(t, target+".part")
+		assert.Equal(t, []string{"NOTICE", "VERSION", "tools/", "tools/tool"}, archiveNames(t, target), pair)
+		out := filepath.Join(dir, "x"+pair[0]+pair[1])
+		assert.NoError(t, Unarchive(target, out))
+		for name, expected := range map[string]string{"VERSION": "v1.0.1", "tools/tool": "#!/bin/sh", "NOTICE": "patched"} {
+			data, err := os.ReadFile(filepath.Join(out, name))
+			assert.NoError(t, err, name)
+			assert.Equal(t, expected, string(data), name)
+		}
+		if runtime.GOOS != "windows" {
+			info, err := os.Stat(filepath.Join(out, "tools", "tool"))
+			assert.NoError(t, err)
+			assert.Equal(t, os.FileMode(0755), info.Mode().Perm(), pair)
+		}
+	}
+
+	// Assert the Source may be the Target
+	archive := filepath.Join(dir, "in.zip")
+	assert.NoError(t, Transform(archive, archive, patchRelease))
+	assert.Equal(t, []string{"VERSION", "tools/", "tools/tool"}, archiveNames(t, archive))
+}
+
+// TestTransformErrors is a unit test for zip.Transform() leaving the target untouched on failure
+func TestTransformErrors(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "alpha", "b.txt": "bravo"})
+	archive := filepath.Join(dir, "in.zip")
+	assert.NoError(t, Archive(source, archive))
+	original, err := os.ReadFile(archive)
+	assert.NoError(t, err)
+
+	// Assert Unit Test
+	err = Transform(archive, archive, func(entry Entry, contents io.Reader) (Entry, io.Reader, error) {
+		return entry, contents, io.ErrUnexpectedEOF
+	})
+	assert.True(t, errors.Is(err, io.ErrUnexpectedEOF), err)
+	assert.ErrorContains(t, err, "Unable to transform 'a.txt'")
+	assert.NoFileExists(t, archive+".part")
+	data, err := os.ReadFile(archive)
+	assert.NoError(t, err)
+	assert.True(t, bytes.Equal(original, data))
+
+	// Assert Renamed Entries must not Collide
+	target := filepath.Join(dir, "collision.zip")
+	assert.Error(t, Transform(archive, target, func(entry Entry, contents io.Reader) (Entry, io.Reader, error) {
+		entry.Name = "same"
+		return entry, contents, nil
+	}))
+	assert.NoFileExists(t, target)
+	assert.NoFileExists(t, target+".part")
+
+	// Assert Invalid Parameters
+	copyEntry := func(entry Entry, contents io.Reader) (Entry, io.Reader, error) { return entry, contents, nil }
+	assert.ErrorContains(t, Transform("", target, copyEntry), "The 'source' parameter was empty")
+	assert.ErrorContains(t, Transform(archive, "", copyEntry), "The 'target' parameter was empty")
+	assert.ErrorContains(t, Transform(archive, target, nil), "The 'fn' parameter was nil")
+	assert.ErrorContains(t, Transform(archive, target, copyEntry, TransformOptions{Add: []Source{{Path: "../x", FS: fstest.MapFS{}}}}), "is not a valid io/fs path")
+	assert.Error(t, Transform(filepath.Join(dir, "missing.zip"), target, copyEntry))
+	assert.NoFileExists(t, target)
+}
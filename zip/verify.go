@@ -0,0 +1,255 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"aead.dev/minisign"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/knowntraveler/gogo/fs"
+)
+
+// DownloadVerified Function for Downloading an Archive File from a HTTP Source and
+// verifying its SHA-256 checksum (hex encoded, or prefixed with another algorithm
+// accepted by fs.VerifyChecksum, e.g. "sha512:..."). The download is written next to
+// the target and only renamed into place once verified, so an unverified file never
+// appears at the target path.
+func DownloadVerified(source string, target string, sha256hex string, options ...DownloadOptions) error {
+
+	// Validate Checksum Parameter
+	if sha256hex == "" {
+		return fmt.Errorf("The 'sha256hex' parameter was empty. A checksum is required to verify a Download")
+	}
+	expected := sha256hex
+	if !strings.Contains(expected, ":") {
+		expected = string(fs.SHA256) + ":" + expected
+	}
+
+	// Download to a Temporary Path next to the Target
+	tmp := target + ".part"
+	err := Download(source, tmp, options...)
+	if err != nil {
+		return err
+	}
+
+	// Verify Checksum
+	err = fs.VerifyChecksum(tmp, expected)
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	// Rename Verified Download into place
+	return os.Rename(tmp, target)
+}
+
+// VerifyChecksumFile Function for Verifying a File against a detached checksum file, either
+// a single checksum (e.g. release.zip.sha256) or a list in the format written by sha256sum
+// ("<hex>  release.zip") or BSD tools ("SHA256 (release.zip) = <hex>"). The entry for the
+// file is found by its base name.
+func VerifyChecksumFile(path string, checksums string) error {
+
+	// Read Checksum File
+	data, err := os.ReadFile(checksums)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("File '%v' doesn't exist", checksums)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to read file '%v': %w", checksums, err)
+	}
+
+	expected, err := findChecksum(data, filepath.Base(path))
+	if err != nil {
+		return fmt.Errorf("Checksum file '%v': %v", checksums, err)
+	}
+
+	// Verify Checksum
+	return fs.VerifyChecksum(path, expected)
+}
+
+// findChecksum returns the expected checksum (prefixed with its algorithm when known)
+// of a file name in the contents of a checksum file
+func findChecksum(data []byte, name string) (string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			lines = append(lines, line)
+		}
+	}
+
+	// Single Checksum
+	if len(lines) == 1 && len(strings.Fields(lines[0])) == 1 {
+		return lines[0], nil
+	}
+
+	for _, line := range lines {
+		entry, checksum, ok := parseChecksumLine(line)
+		if ok && path.Base(filepath.ToSlash(entry)) == name {
+			return checksum, nil
+		}
+	}
+
+	return "", fmt.Errorf("File '%v' is not listed", name)
+}
+
+// parseChecksumLine returns the file name and expected checksum (prefixed with its
+// algorithm when known) of a line of a checksum file
+func parseChecksumLine(line string) (string, string, bool) {
+
+	// BSD Format: ALGORITHM (name) = checksum
+	if open := strings.Index(line, " ("); open > 0 {
+		if closing := strings.LastIndex(line, ") = "); closing > open {
+			return line[open+2 : closing], strings.ToLower(line[:open]) + ":" + strings.TrimSpace(line[closing+4:]), true
+		}
+	}
+
+	// GNU Format: checksum  name (or checksum *name for binary mode)
+	fields := strings.SplitN(line, " ", 2)
+	if len(fields) != 2 {
+		return "", "", false
+	}
+	return strings.TrimPrefix(strings.TrimLeft(fields[1], " "), "*"), fields[0], true
+}
+
+// VerifyMinisign Function for Verifying a File against a detached minisign signature
+// (e.g. release.zip.minisig), publicKey is either the base64 encoded public key (as
+// printed by minisign -G) or the path of a minisign public key file
+func VerifyMinisign(path string, signature string, publicKey string) error {
+
+	// Parse Public Key
+	var key minisign.PublicKey
+	if err := key.UnmarshalText([]byte(strings.TrimSpace(publicKey))); err != nil {
+		key, err = minisign.PublicKeyFromFile(publicKey)
+		if err != nil {
+			return fmt.Errorf("Public key '%v' is not a minisign public key or file: %v", publicKey, err)
+		}
+	}
+
+	// Read Signature
+	sig, err := os.ReadFile(signature)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("File '%v' doesn't exist", signature)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to read file '%v': %w", signature, err)
+	}
+	var parsed minisign.Signature
+	if err := parsed.UnmarshalText(sig); err != nil {
+		return fmt.Errorf("File '%v' is not a minisign signature: %v", signature, err)
+	}
+
+	// Open File
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("File '%v' doesn't exist", path)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to open file '%v': %w", path, err)
+	}
+	defer file.Close()
+
+	// Verify Signature (streaming the file through the message digest,
+	// legacy signatures are verified against the whole file)
+	var valid bool
+	if parsed.Algorithm == minisign.EdDSA {
+		message, err := io.ReadAll(file)
+		if err != nil {
+			return err
+		}
+		valid = minisign.Verify(key, message, sig)
+	} else {
+		reader := minisign.NewReader(file)
+		if _, err := io.Copy(io.Discard, reader); err != nil {
+			return err
+		}
+		valid = reader.Verify(key, sig)
+	}
+	if !valid {
+		return fmt.Errorf("File '%v' minisign signature '%v' is invalid", path, signature)
+	}
+
+	return nil
+}
+
+// VerifyGPG Function for Verifying a File against a detached OpenPGP (GPG) signature
+// (e.g. release.zip.asc or release.zip.sig), keyring is the path of a file with the
+// trusted public keys (ASCII armored or binary, e.g. from gpg --export)
+func VerifyGPG(path string, signature string, keyring string) error {
+
+	// Read Public Keys
+	keys, err := readGPGFile(keyring)
+	if err != nil {
+		return err
+	}
+	var entities openpgp.EntityList
+	if isArmored(keys) {
+		entities, err = openpgp.ReadArmoredKeyRing(bytes.NewReader(keys))
+	} else {
+		entities, err = openpgp.ReadKeyRing(bytes.NewReader(keys))
+	}
+	if err != nil {
+		return fmt.Errorf("Keyring '%v' is not an OpenPGP keyring: %v", keyring, err)
+	}
+
+	// Read Signature
+	sig, err := readGPGFile(signature)
+	if err != nil {
+		return err
+	}
+
+	// Open File
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("File '%v' doesn't exist", path)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to open file '%v': %w", path, err)
+	}
+	defer file.Close()
+
+	// Verify Signature
+	if isArmored(sig) {
+		_, err = openpgp.CheckArmoredDetachedSignature(entities, file, bytes.NewReader(sig), nil)
+	} else {
+		_, err = openpgp.CheckDetachedSignature(entities, file, bytes.NewReader(sig), nil)
+	}
+	if err != nil {
+		return fmt.Errorf("File '%v' GPG signature '%v' is invalid: %v", path, signature, err)
+	}
+
+	return nil
+}
+
+// readGPGFile reads a keyring or signature file
+func readGPGFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("File '%v' doesn't exist", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read file '%v': %w", path, err)
+	}
+	return data, nil
+}
+
+// isArmored reports whether OpenPGP data is ASCII armored
+func isArmored(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte("-----BEGIN PGP"))
+}
@@ -0,0 +1,177 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"aead.dev/minisign"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeRelease writes a release file, returning its path and hex encoded SHA-256 checksum
+func writeRelease(t *testing.T, dir string, data []byte) (string, string) {
+	path := filepath.Join(dir, "release.zip")
+	assert.NoError(t, os.WriteFile(path, data, 0644))
+	sum := sha256.Sum256(data)
+	return path, hex.EncodeToString(sum[:])
+}
+
+// TestDownloadVerified is a unit test for zip.DownloadVerified()
+func TestDownloadVerified(t *testing.T) {
+	data := []byte("release contents")
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.Write(data) }))
+	defer server.Close()
+	target := filepath.Join(t.TempDir(), "release.zip")
+
+	// Assert a Checksum Mismatch leaves no File
+	assert.Error(t, DownloadVerified(server.URL, target, checksum[:63]+"0"))
+	assert.NoFileExists(t, target)
+	assert.NoFileExists(t, target+".part")
+	assert.ErrorContains(t, DownloadVerified(server.URL, target, ""), "The 'sha256hex' parameter was empty")
+
+	// Assert Unit Test
+	assert.NoError(t, DownloadVerified(server.URL, target, checksum))
+	downloaded, err := os.ReadFile(target)
+	assert.NoError(t, err)
+	assert.Equal(t, data, downloaded)
+	assert.NoError(t, DownloadVerified(server.URL, target, "sha256:"+checksum))
+}
+
+// TestVerifyChecksumFile is a unit test for zip.VerifyChecksumFile()
+func TestVerifyChecksumFile(t *testing.T) {
+	dir := t.TempDir()
+	path, checksum := writeRelease(t, dir, []byte("release contents"))
+
+	for name, contents := range map[string]string{
+		"single":    checksum + "\n",
+		"sha256sum": "# checksums\nabc  other.zip\n" + checksum + " *dist/release.zip\n",
+		"bsd":       "SHA256 (other.zip) = abc\nSHA256 (release.zip) = " + checksum + "\n",
+	} {
+		checksums := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(checksums, []byte(contents), 0644))
+
+		// Assert Unit Test
+		assert.NoError(t, VerifyChecksumFile(path, checksums), name)
+	}
+
+	// Assert Unlisted and Mismatched Files are Rejected
+	checksums := filepath.Join(dir, "unlisted")
+	assert.NoError(t, os.WriteFile(checksums, []byte("abc  other.zip\ndef  another.zip\n"), 0644))
+	assert.ErrorContains(t, VerifyChecksumFile(path, checksums), "File 'release.zip' is not listed")
+	checksums = filepath.Join(dir, "mismatch")
+	assert.NoError(t, os.WriteFile(checksums, []byte(checksum[:63]+"0  release.zip\n"), 0644))
+	assert.Error(t, VerifyChecksumFile(path, checksums))
+
+	// Assert only a Missing File doesn't exist
+	missing := filepath.Join(dir, "missing")
+	assert.EqualError(t, VerifyChecksumFile(path, missing), "File '"+missing+"' doesn't exist")
+	err := VerifyChecksumFile(path, dir)
+	assert.ErrorContains(t, err, "Unable to read file")
+	assert.NotContains(t, err.Error(), "doesn't exist")
+}
+
+// TestVerifyMinisign is a unit test for zip.VerifyMinisign()
+func TestVerifyMinisign(t *testing.T) {
+	dir := t.TempDir()
+	data := []byte("release contents")
+	path, _ := writeRelease(t, dir, data)
+	publicKey, privateKey, err := minisign.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	keyFile, err := publicKey.MarshalText()
+	assert.NoError(t, err)
+	key := string(keyFile[bytes.LastIndexByte(keyFile, '\n')+1:])
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "minisign.pub"), keyFile, 0644))
+
+	// Sign with Legacy (whole file) and Prehashed Signatures
+	assert.NoError(t, os.WriteFile(path+".minisig", minisign.Sign(privateKey, data), 0644))
+	reader := minisign.NewReader(bytes.NewReader(data))
+	_, err = reader.Read(make([]byte, 100))
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(path+".hashed.minisig", reader.Sign(privateKey), 0644))
+
+	// Assert Unit Test
+	for _, signature := range []string{path + ".minisig", path + ".hashed.minisig"} {
+		assert.NoError(t, VerifyMinisign(path, signature, key), signature)
+		assert.NoError(t, VerifyMinisign(path, signature, filepath.Join(dir, "minisign.pub")), signature)
+	}
+
+	// Assert Bad Signatures are Rejected
+	otherKey, _, err := minisign.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	otherKeyFile, err := otherKey.MarshalText()
+	assert.NoError(t, err)
+	assert.ErrorContains(t, VerifyMinisign(path, path+".minisig", string(otherKeyFile)), "is invalid")
+	assert.ErrorContains(t, VerifyMinisign(path, path+".minisig", "not a key"), "is not a minisign public key")
+	assert.ErrorContains(t, VerifyMinisign(path, path, key), "is not a minisign signature")
+	assert.NoError(t, os.WriteFile(path, []byte("tampered"), 0644))
+	for _, signature := range []string{path + ".minisig", path + ".hashed.minisig"} {
+		assert.ErrorContains(t, VerifyMinisign(path, signature, key), "is invalid", signature)
+	}
+	assert.ErrorContains(t, VerifyMinisign(path, path+".missing", key), "doesn't exist")
+	assert.ErrorContains(t, VerifyMinisign(path, dir, key), "Unable to read file")
+}
+
+// TestVerifyGPG is a unit test for zip.VerifyGPG()
+func TestVerifyGPG(t *testing.T) {
+	dir := t.TempDir()
+	data := []byte("release contents")
+	path, _ := writeRelease(t, dir, data)
+	entity, err := openpgp.NewEntity("gogo", "", "gogo@example.com", nil)
+	assert.NoError(t, err)
+
+	// Write Armored and Binary Keyrings
+	var armored bytes.Buffer
+	writer, err := armor.Encode(&armored, openpgp.PublicKeyType, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, entity.Serialize(writer))
+	assert.NoError(t, writer.Close())
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "keys.asc"), armored.Bytes(), 0644))
+	var binary bytes.Buffer
+	assert.NoError(t, entity.Serialize(&binary))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "keys.gpg"), binary.Bytes(), 0644))
+
+	// Write Armored and Binary Signatures
+	var signature bytes.Buffer
+	assert.NoError(t, openpgp.ArmoredDetachSign(&signature, entity, bytes.NewReader(data), nil))
+	assert.NoError(t, os.WriteFile(path+".asc", signature.Bytes(), 0644))
+	signature.Reset()
+	assert.NoError(t, openpgp.DetachSign(&signature, entity, bytes.NewReader(data), nil))
+	assert.NoError(t, os.WriteFile(path+".sig", signature.Bytes(), 0644))
+
+	// Assert Unit Test
+	assert.NoError(t, VerifyGPG(path, path+".asc", filepath.Join(dir, "keys.asc")))
+	assert.NoError(t, VerifyGPG(path, path+".sig", filepath.Join(dir, "keys.gpg")))
+
+	// Assert Bad Signatures are Rejected
+	other, err := openpgp.NewEntity("other", "", "other@example.com", nil)
+	assert.NoError(t, err)
+	binary.Reset()
+	assert.NoError(t, other.Serialize(&binary))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "other.gpg"), binary.Bytes(), 0644))
+	assert.ErrorContains(t, VerifyGPG(path, path+".sig", filepath.Join(dir, "other.gpg")), "is invalid")
+	assert.ErrorContains(t, VerifyGPG(path, path+".sig", path), "is not an OpenPGP keyring")
+	assert.NoError(t, os.WriteFile(path, []byte("tampered"), 0644))
+	assert.ErrorContains(t, VerifyGPG(path, path+".asc", filepath.Join(dir, "keys.asc")), "is invalid")
+	assert.ErrorContains(t, VerifyGPG(path, path+".sig", filepath.Join(dir, "keys.gpg")), "is invalid")
+	assert.ErrorContains(t, VerifyGPG(path, path+".sig", filepath.Join(dir, "missing.gpg")), "doesn't exist")
+	assert.ErrorContains(t, VerifyGPG(path, path+".sig", dir), "Unable to read file")
+}
@@ -11,17 +11,27 @@ package zip
 
 import (
 	"archive/zip"
+	"compress/flate"
+	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/knowntraveler/gogo/fs"
 )
 
 // Download Function for Downloading an Archive File (.zip) from a HTTP Source
-func Download(source string, target string) error {
+func Download(source string, target string, options ...DownloadOptions) error {
+	return DownloadContext(context.Background(), source, target, options...)
+}
+
+// DownloadContext Function for Downloading an Archive File (.zip) from a HTTP Source,
+// aborting the download when the context is cancelled and removing the partial target.
+// Responses with a non-2xx status code fail instead of saving the error page.
+func DownloadContext(ctx context.Context, source string, target string, options ...DownloadOptions) error {
 
 	// Parse source url and validate 'source' is a valid HTTP URL
 	_, err := url.ParseRequestURI(source)
@@ -29,23 +39,47 @@ func Download(source string, target string) error {
 		return err
 	}
 
+	// Apply Download Options
+	downloadOptions := DownloadOptions{}
+	if len(options) > 0 {
+		downloadOptions = options[0]
+	}
+	if downloadOptions.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, downloadOptions.Timeout)
+		defer cancel()
+	}
+
 	// Get the source data
-	resp, err := http.Get(source)
+	request, err := newRequest(ctx, source, downloadOptions)
+	if err != nil {
+		return err
+	}
+	resp, err := downloadOptions.client().Do(request)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
+	// Check Response Status
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("Download '%v' failed: %v", source, resp.Status)
+	}
+
 	// Create the .zip file on the local filesystem
 	out, err := os.Create(target)
 	if err != nil {
 		return err
 	}
-	defer out.Close()
 
-	// Write the body to .zip file
-	_, err = io.Copy(out, resp.Body)
+	// Write the body to .zip file (removing the partial file on failure)
+	body := withProgress(contextReader{ctx, resp.Body}, 0, resp.ContentLength, downloadOptions.Progress)
+	_, err = io.Copy(out, body)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
+		os.Remove(target)
 		return err
 	}
 
@@ -53,94 +87,248 @@ func Download(source string, target string) error {
 
 }
 
-// Archive Function for Zipping an Archive File (.zip) from local filesystem
-func Archive(source string, target string) error {
+// Archive Function for Zipping an Archive File (.zip) from local filesystem, targets
+// with a tar extension (e.g. .tar.gz, .tar.zst or .tar.xz) are written as tar archives
+func Archive(source string, target string, options ...ArchiveOptions) error {
+	return ArchiveContext(context.Background(), source, target, options...)
+}
+
+// ArchiveContext Function for Zipping an Archive File (.zip) from local filesystem,
+// aborting when the context is cancelled and removing the partial archive
+func ArchiveContext(ctx context.Context, source string, target string, options ...ArchiveOptions) error {
+
+	// Validate Source Parameter
+	if source == "" {
+		return fmt.Errorf("The 'source' parameter was empty. A source is required to create a Zip Archive")
+	}
+
+	return archiveSources(ctx, []Source{{Path: source}}, target, options)
+}
+
+// ArchiveSources Function for Zipping an Archive File (.zip) from several files and
+// directories on local filesystem (e.g. a binary, LICENSE and README), each added
+// below its Source.Prefix inside the archive. Targets with a tar extension are
+// written as tar archives.
+func ArchiveSources(sources []Source, target string, options ...ArchiveOptions) error {
+	return archiveSources(context.Background(), sources, target, options)
+}
+
+// archiveSources creates a zip or tar archive from sources
+func archiveSources(ctx context.Context, sources []Source, target string, options []ArchiveOptions) error {
 
 	// Validate Target Parameter
 	if target == "" {
 		return fmt.Errorf("The 'target' parameter was empty. A target is required to create a Zip Archive")
 	}
 
-	// Validate Source Parameter
-	if source == "" {
-		return fmt.Errorf("The 'source' parameter was empty. A source is required to create a Zip Archive")
+	// Validate Sources Parameter
+	err := validateSources(sources)
+	if err != nil {
+		return err
+	}
+
+	// Apply Archive Options
+	archiveOptions := ArchiveOptions{}
+	if len(options) > 0 {
+		archiveOptions = options[0]
+	}
+
+	// Check Tar Archive Options
+	if _, ok := tarFormat(target); ok && archiveOptions.Password != "" {
+		return fmt.Errorf("Archive '%v' can't be encrypted, passwords are only supported for zip archives", target)
+	}
+
+	// Pin Modification Time of Reproducible Archives
+	if archiveOptions.Reproducible {
+		archiveOptions.ModTime, err = reproducibleTime(archiveOptions.ModTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Preview Archive
+	if archiveOptions.DryRun != nil {
+		return planArchive(sources, target, archiveOptions)
+	}
+
+	// Create Tar or Zip Archive (the partial archive is removed on failure)
+	if compressor, ok := tarFormat(target); ok {
+		err = createTar(ctx, sources, target, compressor, archiveOptions)
+	} else {
+		err = createArchive(ctx, sources, target, archiveOptions)
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return err
+}
+
+// createArchive Function for Creating an Archive File (.zip) from sources on local filesystem,
+// removing the partial archive on failure
+func createArchive(ctx context.Context, sources []Source, target string, options ArchiveOptions) error {
+
+	// Verify Sources Exist
+	for _, source := range sources {
+		_, err := source.stat()
+		if err != nil {
+			return err
+		}
 	}
 
-	// Create Archive
-	err := createArchive(source, target)
+	// Create Zip Archive File (or the parts of a split archive)
+	zipfile, err := createOutput(target, options)
 	if err != nil {
 		return err
 	}
 
+	// Write Archive
+	err = writeZip(ctx, zipfile, sources, options)
+	if closeErr := zipfile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		removeOutput(zipfile, target)
+		return err
+	}
+
 	return nil
 }
 
-// createArchive Function for Creating an Archive File (.zip) from a source on local filesystem
-func createArchive(source string, target string) error {
+// writeZip writes the files and directories below sources to a zip stream
+func writeZip(ctx context.Context, w io.Writer, sources []Source, options ArchiveOptions) error {
 
-	// Create Zip Archive File
-	zipfile, err := os.Create(target)
+	// Create Archive Filter
+	filter, err := newArchiveFilter(options)
 	if err != nil {
 		return err
 	}
-	defer zipfile.Close()
 
-	// Create New Writer for Zipfile
-	archive := zip.NewWriter(zipfile)
-	defer archive.Close()
+	// Find Duplicate Files
+	duplicates, err := findDuplicates(sources, filter, options)
+	if err != nil {
+		return err
+	}
 
-	// Verify New Zip Archive File Exists
-	info, err := os.Stat(source)
+	// Count Entries for Progress Callbacks
+	progress, err := newArchiveProgress(sources, filter, duplicates, options)
 	if err != nil {
 		return err
 	}
 
-	// Verify Source is a Directory
-	var baseDir string
-	if info.IsDir() {
-		baseDir = filepath.Base(source)
+	// Create New Writer for Zipfile
+	z := &zipArchiver{
+		ctx:        ctx,
+		archive:    newZipWriter(w, options.Level),
+		filter:     filter,
+		entries:    entrySet{},
+		duplicates: duplicates,
+		progress:   progress,
+		options:    options,
+	}
+
+	// Write Sources
+	for _, source := range sources {
+		err = z.writeSource(source)
+		if err != nil {
+			break
+		}
+	}
+
+	// Flush Archive
+	if closeErr := z.archive.Close(); err == nil {
+		err = closeErr
 	}
 
+	return err
+}
+
+// newZipWriter returns a zip.Writer deflating files at the CompressionLevel
+func newZipWriter(w io.Writer, level CompressionLevel) *zip.Writer {
+	archive := zip.NewWriter(w)
+	if level != DefaultCompression {
+		flateLevel := level.flate()
+		archive.RegisterCompressor(zip.Deflate, func(w io.Writer) (io.WriteCloser, error) {
+			return flate.NewWriter(w, flateLevel)
+		})
+	}
+	return archive
+}
+
+// zipArchiver holds the state of writing sources to a zip.Writer
+type zipArchiver struct {
+	ctx        context.Context
+	archive    *zip.Writer
+	filter     *archiveFilter
+	entries    entrySet
+	duplicates map[string]string
+	progress   *archiveProgress
+	options    ArchiveOptions
+}
+
+// writeSource writes the files and directories below a source to the archive
+func (z *zipArchiver) writeSource(source Source) error {
+
 	// Walk Source Filepath
-	filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+	return source.walk(func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
+		if z.ctx.Err() != nil {
+			return z.ctx.Err()
+		}
+
+		// Set Relative Path (the root of a directory source has no entry)
+		rel, err := source.relPath(path)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		// Skip Filtered Paths
+		if z.filter.skip(rel, info.IsDir()) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
 
 		// Get File Header Info
 		header, err := zip.FileInfoHeader(info)
 		if err != nil {
 			return err
 		}
+		if z.options.Reproducible {
+			header.Modified = z.options.ModTime
+			header.SetMode(normalMode(info.Mode()))
+		}
 
-		// Verify Base Directory
-		if baseDir != "" {
-			if baseDir == "." {
-				// Set Archive File Header
-				header.Name = filepath.ToSlash(filepath.Join(baseDir, path))
-			} else {
-				// Set Archive File Header
-				prefix := baseDir + "/"
-				header.Name = filepath.ToSlash(path)
-				header.Name = filepath.ToSlash(filepath.Join(strings.TrimPrefix(header.Name, prefix)))
-
-				// ROOT DIRECTORY CHECK
-				// Check if baseDir matches header.Name
-				if baseDir == header.Name {
-					return nil
-				}
-			}
+		// Set Archive File Header
+		header.Name = source.entryName(rel)
+		added, err := z.entries.add(header.Name, info.IsDir())
+		if err != nil || !added {
+			return err
+		}
+
+		// Store Duplicate Files as Symbolic Links to their First Copy (zip archives
+		// have no hard links)
+		original, duplicate := z.duplicates[header.Name]
+		if duplicate {
+			header.SetMode(os.ModeSymlink | 0777)
 		}
 
 		// Check if Archive File Header is a Directory
 		if info.IsDir() {
 			header.Name += "/"
 		} else {
-			header.Method = zip.Deflate
+			header.Method = z.method(rel)
+			if z.options.Password != "" {
+				encryptHeader(z.archive, header, z.options.Password, z.options.Level.flate())
+			}
 		}
 
 		// Create Header for Source File
-		writer, err := archive.CreateHeader(header)
+		z.progress.entry(header.Name)
+		writer, err := z.archive.CreateHeader(header)
 		if err != nil {
 			return err
 		}
@@ -148,65 +336,215 @@ func createArchive(source string, target string) error {
 			return nil
 		}
 
+		// Store Symbolic Link Target as Contents
+		if duplicate {
+			link, err := duplicateLink(header.Name, original)
+			if err != nil {
+				return err
+			}
+			_, err = io.WriteString(writer, link)
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err := source.readLink(path)
+			if err != nil {
+				return err
+			}
+			_, err = io.WriteString(writer, filepath.ToSlash(link))
+			return err
+		}
+
 		// Open Source File
-		file, err := os.Open(path)
+		file, err := source.open(path)
 		if err != nil {
 			return err
 		}
 		defer file.Close()
 
 		// Copy Source File to Archive (.zip)
-		_, err = io.Copy(writer, file)
+		_, err = io.Copy(writer, z.progress.reader(contextReader{z.ctx, file}))
 		return err
 	})
+}
 
-	return nil
+// writeEntry writes an archive entry with its contents to the archive (the target of
+// a symbolic link is Entry.Link, a file with a Link and no contents is a hard link,
+// which is stored as a symbolic link)
+func (z *zipArchiver) writeEntry(entry Entry, contents io.Reader) error {
+
+	// Set Archive File Header
+	name := strings.TrimSuffix(entry.Name, "/")
+	added, err := z.entries.add(name, entry.Mode.IsDir())
+	if err != nil || !added {
+		return err
+	}
+	header := &zip.FileHeader{Name: name, Modified: entry.Modified}
+	header.SetMode(entry.Mode)
+
+	// Store Hard Links as Symbolic Links (zip archives have no hard links)
+	link := entry.Link
+	if entry.Mode.IsRegular() && link != "" && contents == nil {
+		header.SetMode(os.ModeSymlink | 0777)
+		link, err = duplicateLink(name, link)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check if Archive File Header is a Directory
+	if entry.Mode.IsDir() {
+		header.Name += "/"
+	} else {
+		header.Method = z.method(name)
+	}
+
+	// Create Header for Entry
+	z.progress.entry(header.Name)
+	writer, err := z.archive.CreateHeader(header)
+	if err != nil || entry.Mode.IsDir() {
+		return err
+	}
+
+	// Store Symbolic Link Target or Copy Contents
+	if header.Mode()&os.ModeSymlink != 0 {
+		_, err = io.WriteString(writer, link)
+		return err
+	}
+	if contents == nil {
+		return nil
+	}
+	_, err = io.Copy(writer, z.progress.reader(contextReader{z.ctx, contents}))
+	return err
 }
 
-// Unarchive Function for Unzipping an Archive File (.zip)
-func Unarchive(source string, target string) error {
+// method returns the compression method of a file, files with an extension in
+// ArchiveOptions.StoreExtensions are stored without compression
+func (z *zipArchiver) method(rel string) uint16 {
+	if z.options.Level == Store {
+		return zip.Store
+	}
+	extensions := z.options.StoreExtensions
+	if extensions == nil {
+		extensions = DefaultStoreExtensions
+	}
+	name := strings.ToLower(rel)
+	for _, extension := range extensions {
+		if strings.HasSuffix(name, strings.ToLower(extension)) {
+			return zip.Store
+		}
+	}
+	return zip.Deflate
+}
+
+// Unarchive Function for Unzipping an Archive File (.zip) or extracting a tar archive
+// (.tar, .tar.gz, .tar.zst, .tar.bz2 or .tar.xz), a 7z archive (.7z) or a RAR archive
+// (.rar), restoring permissions, modification times, symbolic links and the hard links
+// of tar archives. Split archives are read from their first part (e.g. release.zip.001)
+// and spanned zip archives (.z01, .z02, ..., .zip) from their .zip part. Entries that
+// would be extracted outside the target directory are rejected unless
+// UnarchiveOptions.Unsafe is set.
+func Unarchive(source string, target string, options ...UnarchiveOptions) error {
+	return UnarchiveContext(context.Background(), source, target, options...)
+}
+
+// UnarchiveContext Function for Unzipping an Archive File (.zip) or extracting a tar
+// archive, aborting when the context is cancelled and removing the partially extracted file
+func UnarchiveContext(ctx context.Context, source string, target string, options ...UnarchiveOptions) error {
+
+	// Apply Unarchive Options
+	unarchiveOptions := UnarchiveOptions{}
+	if len(options) > 0 {
+		unarchiveOptions = options[0]
+	}
 
-	// Create a zipReader out of the Source (.zip)
-	zipReader, err := zip.OpenReader(source)
+	// Preview Extraction
+	if unarchiveOptions.DryRun != nil {
+		return planUnarchive(source, target, unarchiveOptions)
+	}
+
+	// Extract 7z and RAR Archives (read only)
+	switch {
+	case isSevenZip(source):
+		return unarchiveSevenZip(ctx, source, target, unarchiveOptions)
+	case isRar(source):
+		return unarchiveRar(ctx, source, target, unarchiveOptions)
+	}
+
+	// Extract Tar Archives
+	if compressor, ok := tarFormat(source); ok {
+		return unarchiveTar(ctx, source, target, compressor, unarchiveOptions)
+	}
+
+	// Create a zipReader out of the Source (.zip, or the parts of a split or spanned archive)
+	zipReader, closer, err := openZipArchive(source)
 	if err != nil {
 		return err
 	}
-	defer zipReader.Close()
+	defer closer.Close()
 
-	// Iterate through each File/Directory found in Source Archive (.zip)
-	for _, file := range zipReader.Reader.File {
+	// Specify what the extracted file name should be.
+	// You can specify a full path or a prefix to move it to a different directory.
+	var targetDir string
+	if target == "" {
+		targetDir = "./"
+	} else {
+		targetDir = target
+	}
+
+	// Count Entries and Bytes for Progress Callbacks
+	progress := &archiveProgress{onEntry: unarchiveOptions.OnEntry, progress: unarchiveOptions.Progress}
+	progress.entries = len(zipReader.File)
+	for _, file := range zipReader.File {
+		progress.size += int64(file.UncompressedSize64)
+	}
 
-		// Open the file inside the zip archive like a normal file
-		zippedFile, err := file.Open()
+	// Check Extraction Limits
+	limits := newExtractLimits(unarchiveOptions)
+	err = limits.check(len(zipReader.File), progress.size)
+	if err != nil {
+		return err
+	}
+
+	// Iterate through each File/Directory found in Source Archive (.zip)
+	var dirs []extractedDir
+	for _, file := range zipReader.File {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		progress.entry(file.Name)
+		err = limits.entry(file.Name, int64(file.UncompressedSize64))
 		if err != nil {
 			return err
 		}
-		defer zippedFile.Close()
 
-		// Specify what the extracted file name should be.
-		// You can specify a full path or a prefix to move it to a different directory.
-		var targetDir string
-		if target == "" {
-			targetDir = "./"
-		} else {
-			targetDir = target
+		// Set Extracted Filepath (rejecting entries that escape the target directory)
+		extractedFilePath, err := extractPath(targetDir, file.Name, unarchiveOptions)
+		if err != nil {
+			return fmt.Errorf("Archive entry '%v' is unsafe: %v", file.Name, err)
 		}
 
-		// Set Extracted Filepath
-		extractedFilePath := filepath.Join(targetDir, file.Name)
+		// Check Existing Files (directories are merged)
+		if !file.Mode().IsDir() {
+			extract, err := checkConflict(file.Name, extractedFilePath, file.Modified, unarchiveOptions)
+			if err != nil {
+				return err
+			}
+			if !extract {
+				continue
+			}
+		}
 
 		// Extract the item (or create directory)
-		if file.FileInfo().IsDir() {
-			// Check if Directory Path Exists
-			if _, err := os.Stat(filepath.Dir(extractedFilePath)); os.IsNotExist(err) {
-				// Directory Path Does Not Exist
-				// Create Directory Path
-				os.MkdirAll(filepath.Dir(extractedFilePath), 0755)
-			}
+		switch mode := file.Mode(); {
+		case mode.IsDir():
 			// Create directories to recreate directory structure inside the zip archive.
-			// Also preserves permissions
-			os.MkdirAll(extractedFilePath, file.Mode())
-		} else {
+			// Permissions and modification times are restored once all entries are extracted
+			err = os.MkdirAll(extractedFilePath, 0755)
+			dirs = append(dirs, extractedDir{extractedFilePath, fileMode(mode, true), file.Modified})
+		case mode&os.ModeSymlink != 0:
+			// Recreate symbolic link (the link target is stored as the contents)
+			err = extractZipLink(file, targetDir, extractedFilePath, unarchiveOptions)
+		default:
 			// Extract regular file since not a directory
 			// Check if File Path Exists
 			if _, err := os.Stat(filepath.Dir(extractedFilePath)); os.IsNotExist(err) {
@@ -215,20 +553,94 @@ func Unarchive(source string, target string) error {
 				os.MkdirAll(filepath.Dir(extractedFilePath), 0755)
 			}
 
-			// Create an output file for writing
-			f, err := os.Create(extractedFilePath)
-			if err != nil {
-				return err
-			}
-			defer f.Close()
-
-			// "Extract" the file by copying zipped file contents to the output file
-			_, err = io.Copy(f, zippedFile)
-			if err != nil {
-				return err
-			}
+			err = extractZipFile(ctx, file, extractedFilePath, progress, limits, unarchiveOptions)
+		}
+		if err != nil {
+			return err
 		}
 	}
 
-	return nil
+	return restoreDirs(dirs)
+}
+
+// extractZipFile writes the contents of a zip entry to a file, removing the
+// partially written file on failure
+func extractZipFile(ctx context.Context, file *zip.File, path string, progress *archiveProgress, limits *extractLimits, options UnarchiveOptions) error {
+
+	// Open the file inside the zip archive like a normal file (decrypting it with the password)
+	zippedFile, err := openZipFile(file, options.Password)
+	if err != nil {
+		return err
+	}
+	defer zippedFile.Close()
+
+	// "Extract" the file by copying zipped file contents to the output file
+	return extractFile(ctx, zippedFile, path, fileMode(file.Mode(), false), file.Modified, progress, limits)
+}
+
+// extractZipLink creates a symbolic link from a zip entry
+func extractZipLink(file *zip.File, target string, path string, options UnarchiveOptions) error {
+
+	// Read Link Target
+	link, err := readZipLink(file, options.Password)
+	if err != nil {
+		return err
+	}
+
+	return extractSymlink(target, path, link, options)
+}
+
+// readZipLink reads the target of a symbolic link stored as the contents of a zip
+// entry, decrypting it with the password if set
+func readZipLink(file *zip.File, password string) (string, error) {
+	zippedFile, err := openZipFile(file, password)
+	if err != nil {
+		return "", err
+	}
+	defer zippedFile.Close()
+
+	return readLink(zippedFile)
+}
+
+// extractPath returns the path an archive entry is extracted to, returning an error
+// if the entry name is absolute, uses ../ to escape the target directory or resolves
+// outside the target directory through a symbolic link already inside it
+func extractPath(target string, name string, options UnarchiveOptions) (string, error) {
+	if options.Unsafe {
+		return filepath.Join(target, name), nil
+	}
+
+	// Sanitize Entry Name
+	path, err := fs.SanitizePath(target, name)
+	if err != nil {
+		return "", err
+	}
+
+	// Check Symbolic Links inside the Target Directory
+	if !fs.IsSubPath(resolvePath(target), resolvePath(path)) {
+		return "", fmt.Errorf("Path '%v' escapes '%v' through a symbolic link", name, target)
+	}
+
+	return path, nil
+}
+
+// resolvePath returns an absolute path with the symbolic links of its deepest
+// existing part resolved
+func resolvePath(path string) string {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	rest := ""
+	for {
+		if real, err := filepath.EvalSymlinks(path); err == nil {
+			return filepath.Join(real, rest)
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			return filepath.Join(path, rest)
+		}
+		rest = filepath.Join(filepath.Base(path), rest)
+		path = parent
+	}
 }
@@ -0,0 +1,177 @@
+// Copyright © 2020 Brian Hooper <knowntraveler.io>
+// Author: Brian Hooper (@KnownTraveler)
+// Project: gogo/zip
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zip
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countingTransport is a http.RoundTripper counting the requests it sends
+type countingTransport struct {
+	requests int32
+}
+
+// RoundTrip sends a request with the default transport
+func (c *countingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&c.requests, 1)
+	return http.DefaultTransport.RoundTrip(request)
+}
+
+// authServer starts a HTTP server requiring an Authorization and User-Agent header
+func authServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(300 * time.Millisecond)
+		}
+		if r.Header.Get("Authorization") != "token abc" || r.Header.Get("User-Agent") != "gogo" {
+			http.Error(w, "<html>denied</html>", http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte("archive"))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestDownload is a unit test for zip.Download() with a custom client and headers
+func TestDownload(t *testing.T) {
+	server := authServer(t)
+	target := filepath.Join(t.TempDir(), "a.zip")
+	transport := &countingTransport{}
+	options := DownloadOptions{
+		Client:  &http.Client{Transport: transport},
+		Headers: map[string]string{"Authorization": "token abc", "User-Agent": "gogo"},
+	}
+
+	// Assert Unit Test
+	assert.NoError(t, Download(server.URL, target, options))
+	data, err := os.ReadFile(target)
+	assert.NoError(t, err)
+	assert.Equal(t, "archive", string(data))
+	assert.Equal(t, int32(1), transport.requests)
+
+	// Assert Headers are sent by DownloadResumable
+	assert.NoError(t, DownloadResumable(server.URL, target+"2", options))
+	assert.Equal(t, int32(2), transport.requests)
+}
+
+// TestDownloadErrors is a unit test for zip.Download() failing on error responses and timeouts
+func TestDownloadErrors(t *testing.T) {
+	server := authServer(t)
+	target := filepath.Join(t.TempDir(), "a.zip")
+
+	// Assert an Error Page isn't Saved
+	assert.ErrorContains(t, Download(server.URL, target), "401 Unauthorized")
+	assert.NoFileExists(t, target)
+	assert.ErrorContains(t, DownloadResumable(server.URL, target, DownloadOptions{}), "401 Unauthorized")
+	assert.NoFileExists(t, target+".part")
+
+	// Assert Unit Test
+	options := DownloadOptions{
+		Headers: map[string]string{"Authorization": "token abc", "User-Agent": "gogo"},
+		Timeout: 50 * time.Millisecond,
+	}
+	assert.Error(t, Download(server.URL+"/slow", target, options))
+	assert.NoFileExists(t, target)
+	assert.Error(t, Download("not a url", target))
+}
+
+// zipMethods returns the compression method of each entry of a zip archive
+func zipMethods(t *testing.T, path string) map[string]uint16 {
+	reader, err := zip.OpenReader(path)
+	assert.NoError(t, err)
+	defer reader.Close()
+	methods := map[string]uint16{}
+	for _, file := range reader.File {
+		methods[file.Name] = file.Method
+	}
+	return methods
+}
+
+// TestCompressionLevel is a unit test for zip.CompressionLevel.flate()
+func TestCompressionLevel(t *testing.T) {
+	assert.Equal(t, flate.DefaultCompression, DefaultCompression.flate())
+	assert.Equal(t, flate.NoCompression, Store.flate())
+	assert.Equal(t, flate.BestSpeed, Fastest.flate())
+	assert.Equal(t, flate.BestCompression, BestCompression.flate())
+	assert.Equal(t, flate.DefaultCompression, CompressionLevel(42).flate())
+}
+
+// TestArchiveLevel is a unit test for zip.Archive() with the Level and StoreExtensions ArchiveOptions
+func TestArchiveLevel(t *testing.T) {
+	dir := t.TempDir()
+	data := bytes.Repeat([]byte("compress me please "), 10000)
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": string(data), "b.PNG": string(data)})
+
+	for name, test := range map[string]struct {
+		options  ArchiveOptions
+		expected map[string]uint16
+	}{
+		"default.zip": {ArchiveOptions{}, map[string]uint16{"a.txt": zip.Deflate, "b.PNG": zip.Store}},
+		"store.zip":   {ArchiveOptions{Level: Store}, map[string]uint16{"a.txt": zip.Store, "b.PNG": zip.Store}},
+		"best.zip":    {ArchiveOptions{Level: BestCompression, StoreExtensions: []string{}}, map[string]uint16{"a.txt": zip.Deflate, "b.PNG": zip.Deflate}},
+		"custom.zip":  {ArchiveOptions{StoreExtensions: []string{".txt"}}, map[string]uint16{"a.txt": zip.Store, "b.PNG": zip.Deflate}},
+	} {
+		archive := filepath.Join(dir, name)
+		assert.NoError(t, Archive(source, archive, test.options))
+
+		// Assert Unit Test
+		assert.Equal(t, test.expected, zipMethods(t, archive), name)
+		contents, err := ReadEntry(archive, "b.PNG")
+		assert.NoError(t, err)
+		assert.Equal(t, data, contents, name)
+	}
+
+	// Assert Levels of Compressed Tar Archives
+	for _, ext := range []string{".tar.gz", ".tar.zst"} {
+		stored := filepath.Join(dir, "store"+ext)
+		best := filepath.Join(dir, "best"+ext)
+		assert.NoError(t, Archive(source, stored, ArchiveOptions{Level: Store}))
+		assert.NoError(t, Archive(source, best, ArchiveOptions{Level: BestCompression}))
+		storedInfo, err := os.Stat(stored)
+		assert.NoError(t, err)
+		bestInfo, err := os.Stat(best)
+		assert.NoError(t, err)
+		assert.Less(t, bestInfo.Size(), storedInfo.Size(), ext)
+		contents, err := ReadEntry(stored, "a.txt")
+		assert.NoError(t, err)
+		assert.Equal(t, data, contents, ext)
+	}
+}
+
+// TestArchiveLevelErrors is a unit test for zip.Archive() failing with a Level
+func TestArchiveLevelErrors(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "src")
+	writeTestTree(t, source, map[string]string{"a.txt": "alpha"})
+
+	// Assert Stored Encrypted Entries require the Password
+	archive := filepath.Join(dir, "stored.zip")
+	assert.NoError(t, Archive(source, archive, ArchiveOptions{Level: Store, Password: "pw"}))
+	assert.Error(t, Unarchive(archive, filepath.Join(dir, "wrong"), UnarchiveOptions{Password: "wrong"}))
+	assert.NoFileExists(t, filepath.Join(dir, "wrong", "a.txt"))
+
+	// Assert Unit Test
+	archive = filepath.Join(dir, "best.tar.bz2")
+	assert.True(t, errors.Is(Archive(source, archive, ArchiveOptions{Level: BestCompression}), ErrCompressionNotSupported))
+	assert.NoFileExists(t, archive)
+}